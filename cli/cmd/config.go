@@ -30,6 +30,7 @@ var configViewCmd = &cobra.Command{
 
 		fmt.Printf("📋 Current Configuration:\n")
 		fmt.Printf("  API URL: %s\n", cfg.APIURL)
+		fmt.Printf("  Auth Mode: %s\n", cfg.AuthMode)
 		return nil
 	},
 }
@@ -41,10 +42,12 @@ var configSetCmd = &cobra.Command{
 	Long: `Set a configuration value and save it to the config file.
 
 Available keys:
-  api-url    Set the CI/CD API URL
+  api-url     Set the CI/CD API URL
+  auth-mode   Set the authentication mode: none, bearer, or oauth
 
 Example:
-  cicd config set api-url http://localhost:8000`,
+  cicd config set api-url http://localhost:8000
+  cicd config set auth-mode oauth`,
 	Args: cobra.ExactArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		key := args[0]
@@ -59,6 +62,13 @@ Example:
 		switch key {
 		case "api-url":
 			cfg.APIURL = value
+		case "auth-mode":
+			switch value {
+			case "none", "bearer", "oauth":
+				cfg.AuthMode = value
+			default:
+				return fmt.Errorf("invalid auth-mode %q: must be one of none, bearer, oauth", value)
+			}
 		default:
 			return fmt.Errorf("unknown configuration key: %s", key)
 		}
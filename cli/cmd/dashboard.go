@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"custom-cicd-cli/internal/display/dashboard"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+// dashboardCmd represents the dashboard command
+var dashboardCmd = &cobra.Command{
+	Use:   "dashboard",
+	Short: "Interactive multi-pipeline/run dashboard",
+	Long: `Open a split-pane TUI listing all active pipelines and runs, with
+live status and a log tail for whichever one is selected.
+
+This replaces the flicker-prone ANSI-clear redraw used by 'monitor' with a
+proper terminal UI: a filterable table on the left, and step progress plus
+a streaming log view for the selected item on the right.
+
+Keys:
+  ↑/↓      move selection
+  enter, l open the selected item's detail/log view
+  c        cancel the selected pipeline/run
+  d        delete the selected pipeline/run (confirm with y)
+  r        retry the selected run's failed steps
+  /        filter by name
+  q        quit`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		program := tea.NewProgram(dashboard.New(apiClient), tea.WithAltScreen())
+		if _, err := program.Run(); err != nil {
+			return fmt.Errorf("dashboard exited with an error: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dashboardCmd)
+}
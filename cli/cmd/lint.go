@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"custom-cicd-cli/internal/display"
+	"custom-cicd-cli/internal/pipelinespec"
+
+	"github.com/spf13/cobra"
+)
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint [pipeline-file]",
+	Short: "Check a pipeline DSL file for errors",
+	Long: `Lint a pipeline DSL file (the same YAML/JSON format accepted by
+'cicd pipeline create-and-run') and report every offending field with its
+line and column, without needing a live backend connection. Use '-' to
+read from stdin.
+
+Example:
+  cicd lint pipeline.yaml
+  cat pipeline.yaml | cicd lint -`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filename := "-"
+		if len(args) > 0 {
+			filename = args[0]
+		}
+
+		var (
+			data []byte
+			err  error
+		)
+		if filename == "-" {
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(filename)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read pipeline spec: %w", err)
+		}
+
+		issues := pipelinespec.Lint(data)
+		if len(issues) == 0 {
+			display.PrintSuccess(fmt.Sprintf("%s is valid", filename))
+			return nil
+		}
+
+		for _, issue := range issues {
+			fmt.Printf("%s:%s\n", filename, issue)
+		}
+		return fmt.Errorf("%d issue(s) found in %s", len(issues), filename)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+}
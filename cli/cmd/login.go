@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"custom-cicd-cli/internal/auth"
+	"custom-cicd-cli/internal/config"
+	"custom-cicd-cli/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+var loginServer string
+var loginToken string
+
+// loginCmd represents the login command
+var loginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Authenticate the CLI against the CI/CD API",
+	Long: `Authenticate the CLI against the CI/CD API.
+
+With no flags, runs the OAuth 2.0 device authorization flow: the CLI
+displays a verification URL and code for you to approve in a browser,
+then stores the issued tokens for future commands.
+
+Pass --token to use a static bearer token instead (config auth-mode
+"bearer"); this is useful for CI environments where a device flow isn't
+practical.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		server := loginServer
+		if server == "" {
+			server = cfg.APIURL
+		}
+
+		if loginToken != "" {
+			if err := auth.NewStore(server).Set(&auth.Credentials{
+				Server:      server,
+				AccessToken: loginToken,
+			}); err != nil {
+				display.PrintError(fmt.Sprintf("Failed to store credentials: %v", err))
+				return err
+			}
+			cfg.AuthMode = "bearer"
+			if err := config.SaveConfig(cfg); err != nil {
+				display.PrintError(fmt.Sprintf("Failed to save config: %v", err))
+				return err
+			}
+			display.PrintSuccess(fmt.Sprintf("Logged in to %s with a bearer token", server))
+			return nil
+		}
+
+		creds, err := auth.DeviceAuth(server, func(verificationURI, userCode string) {
+			display.PrintInfo(fmt.Sprintf("To continue, open %s and enter code: %s", verificationURI, userCode))
+		})
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Login failed: %v", err))
+			return err
+		}
+
+		if err := auth.NewStore(server).Set(creds); err != nil {
+			display.PrintError(fmt.Sprintf("Failed to store credentials: %v", err))
+			return err
+		}
+
+		cfg.AuthMode = "oauth"
+		if err := config.SaveConfig(cfg); err != nil {
+			display.PrintError(fmt.Sprintf("Failed to save config: %v", err))
+			return err
+		}
+
+		display.PrintSuccess(fmt.Sprintf("Logged in to %s", server))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(loginCmd)
+	loginCmd.Flags().StringVar(&loginServer, "server", "", "API URL to authenticate against (default: configured api-url)")
+	loginCmd.Flags().StringVar(&loginToken, "token", "", "Use a static bearer token instead of the device authorization flow")
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"custom-cicd-cli/internal/auth"
+	"custom-cicd-cli/internal/config"
+	"custom-cicd-cli/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+// logoutCmd represents the logout command
+var logoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove stored credentials for the configured API",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := auth.NewStore(cfg.APIURL).Delete(); err != nil {
+			display.PrintError(fmt.Sprintf("Failed to remove credentials: %v", err))
+			return err
+		}
+
+		cfg.AuthMode = "none"
+		if err := config.SaveConfig(cfg); err != nil {
+			display.PrintError(fmt.Sprintf("Failed to save config: %v", err))
+			return err
+		}
+
+		display.PrintSuccess(fmt.Sprintf("Logged out of %s", cfg.APIURL))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(logoutCmd)
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"custom-cicd-cli/internal/client"
+	"custom-cicd-cli/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+// logsCmd represents the logs command
+var logsCmd = &cobra.Command{
+	Use:   "logs <run-id>",
+	Short: "Show or follow a run's logs",
+	Long: `Show a run's logs by streaming them in real time over the same
+SSE connection used by 'pipeline trace' / 'run trace', with colorized
+per-step prefixes. The stream is read until the run finishes, with or
+without --follow; there is no mode that prints only the existing logs
+and exits.
+
+Example:
+  cicd logs <run-id> -f
+  cicd logs <run-id> -f --since 10m
+  cicd logs <run-id> -f --tail 200`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		runID := args[0]
+		follow, _ := cmd.Flags().GetBool("follow")
+		since, _ := cmd.Flags().GetDuration("since")
+		tail, _ := cmd.Flags().GetInt("tail")
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		events, err := apiClient.StreamRunLogs(ctx, runID, client.StreamOptions{Since: since, Tail: tail})
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Failed to start log stream: %v", err))
+			return err
+		}
+
+		for event := range events {
+			display.PrintLogEvent(event)
+			if !follow && event.Type == client.LogEventRunFinished {
+				break
+			}
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(logsCmd)
+
+	logsCmd.Flags().BoolP("follow", "f", false, "Keep streaming until the run finishes")
+	logsCmd.Flags().Duration("since", 0, "Replay logs produced within this duration before now")
+	logsCmd.Flags().Int("tail", 0, "Replay up to this many recent lines before following")
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
+	"custom-cicd-cli/internal/auth"
 	"custom-cicd-cli/internal/client"
 	"custom-cicd-cli/internal/display"
+	"custom-cicd-cli/internal/pipelinespec"
 
 	"github.com/spf13/cobra"
 )
@@ -24,26 +27,31 @@ var pipelineCmd = &cobra.Command{
 var pipelineCreateCmd = &cobra.Command{
 	Use:   "create [pipeline-file]",
 	Short: "Create a new pipeline",
-	Long: `Create a new pipeline from a JSON configuration file.
-Use '-' to read from stdin.
+	Long: `Create a new pipeline from a JSON or YAML configuration file.
+Use '-' to read from stdin, or pass the spec inline with --json/--yaml.
+Besides --var, the file may use ${{ env.X }} and ${{ secrets.Y }} to pull
+in values from the environment and the secret store at submit time; run
+'cicd lint' first to catch mistakes and 'cicd schema print' for the
+editor-facing JSON Schema. --dry-run prints the resolved Pipeline JSON
+instead of submitting it.
 
 Example:
   cicd pipeline create pipeline.json
-  cat pipeline.json | cicd pipeline create -`,
+  cicd pipeline create pipeline.yaml
+  cat pipeline.json | cicd pipeline create -
+  cicd pipeline create --json '{"name":"demo","steps":[...]}'
+  cicd pipeline create pipeline.yaml --var ENV=staging
+  cicd pipeline create pipeline.yaml --dry-run`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var filename string
-		if len(args) == 0 {
-			filename = "-"
-		} else {
-			filename = args[0]
-		}
-
-		pipeline, err := loadPipelineFromFile(filename)
+		pipeline, err := loadPipelineFromCmd(cmd, args)
 		if err != nil {
 			display.PrintError(fmt.Sprintf("Failed to load pipeline: %v", err))
 			return err
 		}
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			return printDryRun(pipeline)
+		}
 
 		response, err := apiClient.CreatePipeline(pipeline)
 		if err != nil {
@@ -160,26 +168,26 @@ var pipelineDeleteCmd = &cobra.Command{
 var pipelineCreateAndRunCmd = &cobra.Command{
 	Use:   "create-and-run [pipeline-file]",
 	Short: "Create and immediately run a pipeline",
-	Long: `Create a new pipeline from a JSON configuration file and immediately run it.
-Use '-' to read from stdin.
+	Long: `Create a new pipeline from a JSON or YAML configuration file and
+immediately run it. Use '-' to read from stdin, or pass the spec inline
+with --json/--yaml. Supports the same ${{ env.X }} / ${{ secrets.Y }}
+interpolation and --dry-run as 'pipeline create'.
 
 Example:
   cicd pipeline create-and-run pipeline.json
-  cat pipeline.json | cicd pipeline create-and-run -`,
+  cat pipeline.json | cicd pipeline create-and-run -
+  cicd pipeline create-and-run --yaml "$(cat pipeline.yaml)" --var ENV=prod
+  cicd pipeline create-and-run pipeline.yaml --dry-run`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var filename string
-		if len(args) == 0 {
-			filename = "-"
-		} else {
-			filename = args[0]
-		}
-
-		pipeline, err := loadPipelineFromFile(filename)
+		pipeline, err := loadPipelineFromCmd(cmd, args)
 		if err != nil {
 			display.PrintError(fmt.Sprintf("Failed to load pipeline: %v", err))
 			return err
 		}
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			return printDryRun(pipeline)
+		}
 
 		response, err := apiClient.CreateAndRunPipeline(pipeline)
 		if err != nil {
@@ -229,29 +237,119 @@ var pipelineMonitorCmd = &cobra.Command{
 	},
 }
 
-// loadPipelineFromFile loads a pipeline configuration from a file or stdin
-func loadPipelineFromFile(filename string) (*client.Pipeline, error) {
-	var reader io.Reader
+// loadPipelineFromCmd resolves a pipeline spec from the --json/--yaml flags,
+// a file argument, or stdin (in that priority order), applies --var
+// substitution and ${{ env.X }} / ${{ secrets.Y }} interpolation, and
+// decodes the pipeline DSL into a client.Pipeline via pipelinespec.
+func loadPipelineFromCmd(cmd *cobra.Command, args []string) (*client.Pipeline, error) {
+	inlineJSON, _ := cmd.Flags().GetString("json")
+	inlineYAML, _ := cmd.Flags().GetString("yaml")
+	varFlags, _ := cmd.Flags().GetStringArray("var")
+
+	vars, err := parseVars(varFlags)
+	if err != nil {
+		return nil, err
+	}
 
-	if filename == "-" {
-		display.PrintInfo("Reading pipeline configuration from stdin...")
-		reader = os.Stdin
-	} else {
-		file, err := os.Open(filename)
+	var (
+		data     []byte
+		filename string
+	)
+
+	switch {
+	case inlineJSON != "":
+		data, filename = []byte(inlineJSON), "<--json>.json"
+	case inlineYAML != "":
+		data, filename = []byte(inlineYAML), "<--yaml>.yaml"
+	default:
+		if len(args) == 0 {
+			filename = "-"
+		} else {
+			filename = args[0]
+		}
+
+		if filename == "-" {
+			display.PrintInfo("Reading pipeline configuration from stdin...")
+			data, err = io.ReadAll(os.Stdin)
+		} else {
+			data, err = os.ReadFile(filename)
+		}
 		if err != nil {
-			return nil, fmt.Errorf("failed to open file %s: %w", filename, err)
+			return nil, fmt.Errorf("failed to read pipeline spec: %w", err)
 		}
-		defer file.Close()
-		reader = file
 	}
 
-	var pipeline client.Pipeline
-	decoder := json.NewDecoder(reader)
-	if err := decoder.Decode(&pipeline); err != nil {
-		return nil, fmt.Errorf("failed to decode pipeline JSON: %w", err)
+	data = substituteVars(data, vars)
+
+	data, err = pipelinespec.Interpolate(data, cmdResolver{})
+	if err != nil {
+		return nil, err
+	}
+
+	spec, err := pipelinespec.Parse(data, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return pipelinespec.ToPipeline(spec), nil
+}
+
+// cmdResolver resolves `${{ env.X }}` against the CLI process's own
+// environment and `${{ secrets.Y }}` against the per-server
+// auth.SecretStore, so a pipeline file can reference a secret by name
+// instead of embedding it.
+type cmdResolver struct{}
+
+func (cmdResolver) Env(name string) (string, bool) {
+	return os.LookupEnv(name)
+}
+
+func (cmdResolver) Secret(name string) (string, bool) {
+	value, ok, err := auth.NewSecretStore(cfg.APIURL).Get(name)
+	if err != nil || !ok {
+		return "", false
 	}
+	return value, true
+}
 
-	return &pipeline, nil
+// printDryRun prints the fully-resolved Pipeline as JSON instead of
+// submitting it, so --dry-run shows exactly what create/create-and-run
+// would send after --var and ${{ }} interpolation.
+func printDryRun(pipeline *client.Pipeline) error {
+	encoded, err := json.MarshalIndent(pipeline, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal pipeline: %w", err)
+	}
+	fmt.Println(string(encoded))
+	return nil
+}
+
+// parseVars turns "key=value" flag values into a substitution map.
+func parseVars(flags []string) (map[string]string, error) {
+	vars := make(map[string]string, len(flags))
+	for _, kv := range flags {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid --var %q, expected key=value", kv)
+		}
+		vars[parts[0]] = parts[1]
+	}
+	return vars, nil
+}
+
+// substituteVars replaces ${VAR} placeholders in data with values from vars
+// before the document is decoded, so the same pipeline file can be
+// parameterized for different environments.
+func substituteVars(data []byte, vars map[string]string) []byte {
+	if len(vars) == 0 {
+		return data
+	}
+
+	text := string(data)
+	for key, value := range vars {
+		text = strings.ReplaceAll(text, "${"+key+"}", value)
+	}
+	return []byte(text)
 }
 
 func init() {
@@ -270,4 +368,11 @@ func init() {
 	// Add flags
 	pipelineRunCmd.Flags().Bool("background", true, "Run pipeline in background")
 	pipelineMonitorCmd.Flags().Int("interval", 2, "Refresh interval in seconds")
+
+	for _, c := range []*cobra.Command{pipelineCreateCmd, pipelineCreateAndRunCmd} {
+		c.Flags().String("json", "", "Inline pipeline spec as a JSON string")
+		c.Flags().String("yaml", "", "Inline pipeline spec as a YAML string")
+		c.Flags().StringArray("var", nil, "Set a ${VAR} substitution as key=value (repeatable)")
+		c.Flags().Bool("dry-run", false, "Print the resolved Pipeline JSON instead of submitting it")
+	}
 } 
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVars(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			flags: nil,
+			want:  map[string]string{},
+		},
+		{
+			name:  "single key=value",
+			flags: []string{"ENV=staging"},
+			want:  map[string]string{"ENV": "staging"},
+		},
+		{
+			name:  "value containing an equals sign",
+			flags: []string{"URL=https://example.test/a=b"},
+			want:  map[string]string{"URL": "https://example.test/a=b"},
+		},
+		{
+			name:    "missing equals sign",
+			flags:   []string{"ENV"},
+			wantErr: true,
+		},
+		{
+			name:    "empty key",
+			flags:   []string{"=staging"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseVars(tt.flags)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseVars(%v) = %v, want error", tt.flags, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseVars(%v) returned unexpected error: %v", tt.flags, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseVars(%v) = %v, want %v", tt.flags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSubstituteVars(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		vars map[string]string
+		want string
+	}{
+		{
+			name: "no vars is a no-op",
+			data: "name: ${ENV}",
+			vars: nil,
+			want: "name: ${ENV}",
+		},
+		{
+			name: "substitutes a placeholder",
+			data: "name: ${ENV}-demo",
+			vars: map[string]string{"ENV": "staging"},
+			want: "name: staging-demo",
+		},
+		{
+			name: "substitutes every occurrence",
+			data: "${ENV} then ${ENV} again",
+			vars: map[string]string{"ENV": "prod"},
+			want: "prod then prod again",
+		},
+		{
+			name: "leaves unmatched placeholders alone",
+			data: "name: ${OTHER}",
+			vars: map[string]string{"ENV": "staging"},
+			want: "name: ${OTHER}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(substituteVars([]byte(tt.data), tt.vars))
+			if got != tt.want {
+				t.Fatalf("substituteVars(%q, %v) = %q, want %q", tt.data, tt.vars, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"custom-cicd-cli/internal/client"
+	"custom-cicd-cli/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+// pipelineRetryCmd retries the most recent run of a pipeline.
+var pipelineRetryCmd = &cobra.Command{
+	Use:   "retry <pipeline-id>",
+	Short: "Retry a pipeline's most recent run",
+	Long: `Retry the most recent run of a pipeline, re-executing only its
+failed or cancelled steps and reusing prior successful step outputs.
+
+Example:
+  cicd pipeline retry <pipeline-id>
+  cicd pipeline retry <pipeline-id> --from-step deploy
+  cicd pipeline retry <pipeline-id> --all --follow`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pipelineID := args[0]
+
+		runs, err := apiClient.ListRuns(pipelineID)
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Failed to list runs for pipeline: %v", err))
+			return err
+		}
+		if len(runs) == 0 {
+			return fmt.Errorf("pipeline %s has no runs to retry", pipelineID)
+		}
+
+		return retryRun(cmd, mostRecentRun(runs).ID)
+	},
+}
+
+// mostRecentRun returns the run with the latest CreatedAt, since
+// ListRuns doesn't guarantee any particular order. A run whose CreatedAt
+// fails to parse as RFC3339 sorts as if it were the oldest, rather than
+// silently winning ties against runs with a valid timestamp.
+func mostRecentRun(runs []client.Run) client.Run {
+	latest := runs[0]
+	latestTime, _ := time.Parse(time.RFC3339, latest.CreatedAt)
+
+	for _, r := range runs[1:] {
+		t, err := time.Parse(time.RFC3339, r.CreatedAt)
+		if err != nil {
+			continue
+		}
+		if t.After(latestTime) {
+			latest, latestTime = r, t
+		}
+	}
+
+	return latest
+}
+
+// runRetryCmd retries a specific run.
+var runRetryCmd = &cobra.Command{
+	Use:   "retry <run-id>",
+	Short: "Retry a run",
+	Long: `Retry a run, re-executing only its failed or cancelled steps and
+reusing prior successful step outputs.
+
+Example:
+  cicd run retry <run-id>
+  cicd run retry <run-id> --from-step deploy
+  cicd run retry <run-id> --all --follow`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return retryRun(cmd, args[0])
+	},
+}
+
+// retryRun calls apiClient.RetryRun with options derived from the command's
+// flags, surfaces the new run ID, and optionally follows it with trace.
+func retryRun(cmd *cobra.Command, runID string) error {
+	fromStep, _ := cmd.Flags().GetString("from-step")
+	all, _ := cmd.Flags().GetBool("all")
+	onlyFailed, _ := cmd.Flags().GetBool("only-failed")
+	follow, _ := cmd.Flags().GetBool("follow")
+
+	opts := client.RetryRunOptions{
+		FromStep:   fromStep,
+		OnlyFailed: onlyFailed && !all,
+	}
+
+	response, err := apiClient.RetryRun(runID, opts)
+	if err != nil {
+		display.PrintError(fmt.Sprintf("Failed to retry run: %v", err))
+		return err
+	}
+
+	display.PrintSuccess("Retry started successfully!")
+	fmt.Printf("🚀 Run ID: %s\n", response.RunID)
+
+	if !follow {
+		return nil
+	}
+
+	return traceLogs(cmd, response.RunID, func() (string, error) {
+		run, err := apiClient.GetRun(response.RunID)
+		if err != nil {
+			return "", err
+		}
+		return run.Status, nil
+	})
+}
+
+func init() {
+	pipelineCmd.AddCommand(pipelineRetryCmd)
+	runCmd.AddCommand(runRetryCmd)
+
+	for _, c := range []*cobra.Command{pipelineRetryCmd, runRetryCmd} {
+		c.Flags().String("from-step", "", "Force re-execution starting at this step")
+		c.Flags().Bool("only-failed", true, "Re-execute only failed/cancelled steps; --only-failed=false re-executes every step, as --all does")
+		c.Flags().Bool("all", false, "Re-execute every step, ignoring prior successful outputs")
+		c.Flags().Bool("follow", false, "Trace the new run's logs until it finishes")
+	}
+}
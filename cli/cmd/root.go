@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 
+	"custom-cicd-cli/internal/auth"
 	"custom-cicd-cli/internal/client"
 	"custom-cicd-cli/internal/config"
 	"custom-cicd-cli/internal/display"
@@ -11,10 +12,12 @@ import (
 )
 
 var (
-	cfgFile   string
-	apiURL    string
-	cfg       *config.Config
-	apiClient *client.Client
+	cfgFile      string
+	apiURL       string
+	outputFormat string
+	noEmoji      bool
+	cfg          *config.Config
+	apiClient    *client.Client
 )
 
 // rootCmd represents the base command when called without any subcommands
@@ -34,6 +37,11 @@ Example usage:
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		var err error
 
+		if err := display.SetOutputFormat(outputFormat); err != nil {
+			return err
+		}
+		display.SetNoEmoji(noEmoji)
+
 		// Load configuration
 		cfg, err = config.LoadConfig()
 		if err != nil {
@@ -47,7 +55,19 @@ Example usage:
 		}
 
 		// Create API client
-		apiClient = client.NewClient(cfg.APIURL)
+		clientOpts := []client.ClientOption{}
+		switch cfg.AuthMode {
+		case "oauth":
+			clientOpts = append(clientOpts, client.WithAuth(auth.NewStoredTokenSource(cfg.APIURL)))
+		case "bearer":
+			creds, err := auth.NewStore(cfg.APIURL).Get()
+			if err != nil {
+				display.PrintWarning(fmt.Sprintf("Could not load stored credentials: %v", err))
+			} else if creds != nil {
+				clientOpts = append(clientOpts, client.WithAuth(auth.NewStaticTokenSource(creds.AccessToken)))
+			}
+		}
+		apiClient = client.NewClient(cfg.APIURL, clientOpts...)
 
 		// Test connection (but don't fail if it's not available)
 		if _, err := apiClient.HealthCheck(); err != nil {
@@ -69,6 +89,9 @@ func init() {
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.custom-cicd/config.yaml)")
 	rootCmd.PersistentFlags().StringVar(&apiURL, "api-url", "", "CI/CD API URL (default: http://localhost:8000)")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "o", "pretty",
+		"Output format: pretty, json, yaml, jsonpath=<expr>, or template=<go-template>")
+	rootCmd.PersistentFlags().BoolVar(&noEmoji, "no-emoji", false, "Disable emoji decoration in pretty output (also honors NO_COLOR)")
 
 	// Add version command
 	rootCmd.AddCommand(&cobra.Command{
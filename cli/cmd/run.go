@@ -8,11 +8,52 @@ import (
 	"github.com/spf13/cobra"
 )
 
-// runCmd represents the run command
+// runCmd represents the run command. With no subcommand it manages
+// existing runs (list/status/cancel/delete); passing -f/--file (or
+// --json/--yaml) instead submits a pipeline spec and runs it immediately, equivalent to
+// 'pipeline create-and-run'.
 var runCmd = &cobra.Command{
 	Use:   "run",
-	Short: "Manage pipeline runs",
-	Long:  `List, monitor, and manage individual pipeline runs.`,
+	Short: "Manage pipeline runs, or submit and run a pipeline with -f",
+	Long: `List, monitor, and manage individual pipeline runs.
+
+Pass -f/--file to submit a pipeline spec and run it immediately instead.
+Accepts the same JSON/YAML file, --json/--yaml, --var, and --dry-run
+handling as 'pipeline create-and-run'.
+
+Example:
+  cicd run list
+  cicd run -f pipeline.yaml
+  cicd run -f pipeline.yaml --var ENV=staging
+  cicd run -f pipeline.yaml --dry-run`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, _ := cmd.Flags().GetString("file")
+		inlineJSON, _ := cmd.Flags().GetString("json")
+		inlineYAML, _ := cmd.Flags().GetString("yaml")
+		if file == "" && inlineJSON == "" && inlineYAML == "" {
+			return cmd.Help()
+		}
+
+		pipeline, err := loadPipelineFromCmd(cmd, []string{file})
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Failed to load pipeline: %v", err))
+			return err
+		}
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			return printDryRun(pipeline)
+		}
+
+		response, err := apiClient.CreateAndRunPipeline(pipeline)
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Failed to create and run pipeline: %v", err))
+			return err
+		}
+
+		display.PrintSuccess("Pipeline created and started successfully!")
+		fmt.Printf("📋 Pipeline ID: %s\n", response.PipelineID)
+		fmt.Printf("🚀 Run ID: %s\n", response.RunID)
+		return nil
+	},
 }
 
 // runListCmd represents the run list command
@@ -111,4 +152,10 @@ func init() {
 	runCmd.AddCommand(runStatusCmd)
 	runCmd.AddCommand(runCancelCmd)
 	runCmd.AddCommand(runDeleteCmd)
+
+	runCmd.Flags().StringP("file", "f", "", "Submit and run a pipeline spec from this file ('-' for stdin), equivalent to 'pipeline create-and-run'")
+	runCmd.Flags().String("json", "", "Inline pipeline spec as a JSON string")
+	runCmd.Flags().String("yaml", "", "Inline pipeline spec as a YAML string")
+	runCmd.Flags().StringArray("var", nil, "Set a ${VAR} substitution as key=value (repeatable)")
+	runCmd.Flags().Bool("dry-run", false, "Print the resolved Pipeline JSON instead of submitting it")
 } 
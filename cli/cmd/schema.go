@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+
+	"custom-cicd-cli/internal/pipelinespec"
+
+	"github.com/spf13/cobra"
+)
+
+// schemaCmd represents the schema command
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Inspect the pipeline DSL schema",
+	Long:  `View the JSON Schema describing the pipeline DSL accepted by 'cicd pipeline create' and 'cicd lint'.`,
+}
+
+// schemaPrintCmd represents the schema print command
+var schemaPrintCmd = &cobra.Command{
+	Use:   "print",
+	Short: "Print the pipeline DSL's JSON Schema",
+	Long: `Print the embedded JSON Schema (draft-07) for the pipeline DSL to
+stdout, for editor integration (e.g. a yaml-language-server
+"# yaml-language-server: $schema=..." comment) or piping into other
+validation tooling.
+
+Example:
+  cicd schema print > pipeline.schema.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fmt.Println(string(pipelinespec.Schema()))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+	schemaCmd.AddCommand(schemaPrintCmd)
+}
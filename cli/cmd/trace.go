@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"custom-cicd-cli/internal/client"
+	"custom-cicd-cli/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+// pipelineTraceCmd streams a pipeline's step output in real time.
+var pipelineTraceCmd = &cobra.Command{
+	Use:   "trace <pipeline-id>",
+	Short: "Stream live pipeline logs",
+	Long: `Stream step output for a pipeline as it runs, the way
+'glab pipeline ci trace' does, instead of polling the whole status view.
+
+Logs from the currently running step are followed automatically. The
+command exits with a non-zero status if the pipeline finishes as
+anything other than success.
+
+Example:
+  cicd pipeline trace <pipeline-id>
+  cicd pipeline trace <pipeline-id> --step build
+  cicd pipeline trace <pipeline-id> --since 5m`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pipelineID := args[0]
+
+		runs, err := apiClient.ListRuns(pipelineID)
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Failed to list runs for pipeline: %v", err))
+			return err
+		}
+		if len(runs) == 0 {
+			return fmt.Errorf("pipeline %s has no runs to trace", pipelineID)
+		}
+		runID := mostRecentRun(runs).ID
+
+		return traceLogs(cmd, runID, func() (string, error) {
+			pipeline, err := apiClient.GetPipeline(pipelineID)
+			if err != nil {
+				return "", err
+			}
+			return pipeline.Status, nil
+		})
+	},
+}
+
+// runTraceCmd streams a run's step output in real time.
+var runTraceCmd = &cobra.Command{
+	Use:   "trace <run-id>",
+	Short: "Stream live run logs",
+	Long: `Stream step output for a run as it executes.
+
+Example:
+  cicd run trace <run-id>
+  cicd run trace <run-id> --step test`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		runID := args[0]
+		return traceLogs(cmd, runID, func() (string, error) {
+			run, err := apiClient.GetRun(runID)
+			if err != nil {
+				return "", err
+			}
+			return run.Status, nil
+		})
+	},
+}
+
+// traceLogs streams logs for id until the stream closes, then looks up the
+// final status via getStatus and exits non-zero if it isn't "success".
+// Ctrl+C stops tracing without cancelling the remote pipeline/run.
+func traceLogs(cmd *cobra.Command, id string, getStatus func() (string, error)) error {
+	since, _ := cmd.Flags().GetDuration("since")
+	step, _ := cmd.Flags().GetString("step")
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	if since > 0 {
+		display.PrintInfo(fmt.Sprintf("Replaying logs from the last %s...", since))
+	}
+	display.PrintInfo(fmt.Sprintf("Tracing %s (Ctrl+C to stop)", id))
+
+	events, err := apiClient.StreamRunLogs(ctx, id, client.StreamOptions{Since: since})
+	if err != nil {
+		display.PrintError(fmt.Sprintf("Failed to start log stream: %v", err))
+		return err
+	}
+
+	for event := range events {
+		if step != "" && event.StepName != step {
+			continue
+		}
+		display.PrintLogEvent(event)
+	}
+
+	status, err := getStatus()
+	if err != nil {
+		display.PrintError(fmt.Sprintf("Failed to get final status: %v", err))
+		return err
+	}
+
+	fmt.Printf("\n🏁 Finished with status: %s\n", status)
+	if status != "success" {
+		return fmt.Errorf("finished with status: %s", status)
+	}
+	return nil
+}
+
+func init() {
+	pipelineCmd.AddCommand(pipelineTraceCmd)
+	runCmd.AddCommand(runTraceCmd)
+
+	for _, c := range []*cobra.Command{pipelineTraceCmd, runTraceCmd} {
+		c.Flags().Duration("since", 0, "Replay logs produced within this duration before now")
+		c.Flags().String("step", "", "Filter output to a single step")
+	}
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"custom-cicd-cli/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+// exitTimeout is returned when --timeout expires before the pipeline/run
+// reaches a terminal status, mirroring the convention used by tools like
+// `timeout(1)`.
+const exitTimeout = 124
+
+var terminalStatuses = map[string]bool{
+	"success":   true,
+	"failed":    true,
+	"cancelled": true,
+}
+
+// pipelineWaitCmd blocks until a pipeline reaches a terminal status.
+var pipelineWaitCmd = &cobra.Command{
+	Use:   "wait <pipeline-id>",
+	Short: "Wait for a pipeline to reach a terminal status",
+	Long: `Block until a pipeline reaches success, failed, or cancelled, then
+exit with a status code reflecting the outcome. Unlike 'monitor', this
+produces no TUI output by default, just a final status line, so it can be
+used directly in shell scripts and other CI systems.
+
+Example:
+  cicd pipeline wait <pipeline-id>
+  cicd pipeline wait <pipeline-id> --timeout 10m --expect success`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pipelineID := args[0]
+		return waitFor(cmd, pipelineID, func() (string, error) {
+			pipeline, err := apiClient.GetPipeline(pipelineID)
+			if err != nil {
+				return "", err
+			}
+			return pipeline.Status, nil
+		})
+	},
+}
+
+// runWaitCmd blocks until a run reaches a terminal status.
+var runWaitCmd = &cobra.Command{
+	Use:   "wait <run-id>",
+	Short: "Wait for a run to reach a terminal status",
+	Long: `Block until a run reaches success, failed, or cancelled, then exit
+with a status code reflecting the outcome.
+
+Example:
+  cicd run wait <run-id>
+  cicd run wait <run-id> --timeout 10m --expect success`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		runID := args[0]
+		return waitFor(cmd, runID, func() (string, error) {
+			run, err := apiClient.GetRun(runID)
+			if err != nil {
+				return "", err
+			}
+			return run.Status, nil
+		})
+	},
+}
+
+// waitFor polls getStatus on an interval until it returns a terminal status,
+// the timeout elapses, or the process receives SIGINT/SIGTERM. Polling stops
+// on a signal without attempting to cancel the remote pipeline/run.
+func waitFor(cmd *cobra.Command, id string, getStatus func() (string, error)) error {
+	pollInterval, _ := cmd.Flags().GetDuration("poll-interval")
+	timeout, _ := cmd.Flags().GetDuration("timeout")
+	expect, _ := cmd.Flags().GetString("expect")
+
+	if expect != "" && expect != "success" && expect != "failed" {
+		return fmt.Errorf("invalid --expect %q, must be \"success\" or \"failed\"", expect)
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigCh)
+
+	var timeoutCh <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := getStatus()
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Failed to get status: %v", err))
+			return err
+		}
+
+		if terminalStatuses[status] {
+			fmt.Printf("%s finished with status: %s\n", id, status)
+			if expect != "" && status != expect {
+				return fmt.Errorf("expected status %q, got %q", expect, status)
+			}
+			if status != "success" && expect == "" {
+				return fmt.Errorf("finished with status: %s", status)
+			}
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+			continue
+		case <-timeoutCh:
+			fmt.Printf("timed out waiting for %s (last status: %s)\n", id, status)
+			os.Exit(exitTimeout)
+		case <-sigCh:
+			display.PrintInfo("Stopped waiting (the remote run is left untouched)")
+			return nil
+		}
+	}
+}
+
+func init() {
+	pipelineCmd.AddCommand(pipelineWaitCmd)
+	runCmd.AddCommand(runWaitCmd)
+
+	for _, c := range []*cobra.Command{pipelineWaitCmd, runWaitCmd} {
+		c.Flags().Duration("poll-interval", 2*time.Second, "Interval between status checks")
+		c.Flags().Duration("timeout", 0, "Maximum time to wait before giving up (0 = no timeout)")
+		c.Flags().String("expect", "", "Fail unless the final status matches: success|failed")
+	}
+}
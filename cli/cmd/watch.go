@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+
+	"custom-cicd-cli/internal/display/watch"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+)
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch [runID]",
+	Short: "Live TUI for a run's steps, or every run with --all",
+	Long: `Watch a run's steps update live: a step table with a spinner and
+elapsed time for each step, and a scrollable log pane for whichever step
+is selected. Streams logs the same way 'pipeline trace' does, falling
+back to periodic GetRun polling (step status only, no log lines) if the
+backend doesn't support streaming for this run.
+
+With --all (or no runID), starts on a filterable table of every run;
+press enter to drill into one.
+
+Keys:
+  ↑/↓      move selection (steps, or runs in --all mode)
+  enter    drill into the selected run (--all mode)
+  esc      back to the run table (--all mode)
+  c        cancel the current/selected run
+  d        delete the current/selected run (confirm with y)
+  /        filter by name/status
+  q        quit`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+
+		var runID string
+		if len(args) > 0 && !all {
+			runID = args[0]
+		}
+		if runID == "" && !all {
+			all = true
+		}
+
+		program := tea.NewProgram(watch.New(apiClient, runID, all), tea.WithAltScreen())
+		if _, err := program.Run(); err != nil {
+			return fmt.Errorf("watch exited with an error: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+	watchCmd.Flags().Bool("all", false, "Start on a table of every run instead of a single runID")
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"custom-cicd-cli/internal/auth"
+	"custom-cicd-cli/internal/display"
+
+	"github.com/spf13/cobra"
+)
+
+// whoamiCmd represents the whoami command
+var whoamiCmd = &cobra.Command{
+	Use:   "whoami",
+	Short: "Show the current authentication status",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if cfg.AuthMode == "none" {
+			fmt.Println("Not logged in (auth-mode: none)")
+			return nil
+		}
+
+		creds, err := auth.NewStore(cfg.APIURL).Get()
+		if err != nil {
+			display.PrintError(fmt.Sprintf("Failed to load credentials: %v", err))
+			return err
+		}
+		if creds == nil {
+			fmt.Printf("Not logged in to %s (auth-mode: %s)\n", cfg.APIURL, cfg.AuthMode)
+			return nil
+		}
+
+		fmt.Printf("Logged in to %s (auth-mode: %s)\n", creds.Server, cfg.AuthMode)
+		if !creds.ExpiresAt.IsZero() {
+			fmt.Printf("Token expires: %s\n", creds.ExpiresAt)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(whoamiCmd)
+}
@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// deviceCodeResponse is the response from POST /oauth/device/code.
+type deviceCodeResponse struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// deviceTokenResponse is the response from POST /oauth/device/token, either
+// a successful grant or a pending/error status reported via Error.
+type deviceTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	Error        string `json:"error"`
+}
+
+// DeviceAuth runs the OAuth 2.0 device authorization grant against server:
+// it requests a device code, invokes prompt with the verification URL and
+// user code for the caller to display, then polls for approval until the
+// user completes the flow or the device code expires.
+func DeviceAuth(server string, prompt func(verificationURI, userCode string)) (*Credentials, error) {
+	server = normalizeServer(server)
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	dc, err := requestDeviceCode(httpClient, server)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+
+	prompt(dc.VerificationURI, dc.UserCode)
+
+	interval := time.Duration(dc.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := time.Now().Add(time.Duration(dc.ExpiresIn) * time.Second)
+
+	for {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("device code expired before authorization was completed")
+		}
+		time.Sleep(interval)
+
+		tok, err := pollDeviceToken(httpClient, server, dc.DeviceCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to poll for device token: %w", err)
+		}
+
+		switch tok.Error {
+		case "":
+			return &Credentials{
+				Server:       server,
+				AccessToken:  tok.AccessToken,
+				RefreshToken: tok.RefreshToken,
+				ExpiresAt:    time.Now().Add(time.Duration(tok.ExpiresIn) * time.Second),
+			}, nil
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += 5 * time.Second
+			continue
+		default:
+			return nil, fmt.Errorf("authorization failed: %s", tok.Error)
+		}
+	}
+}
+
+func requestDeviceCode(httpClient *http.Client, server string) (*deviceCodeResponse, error) {
+	resp, err := httpClient.PostForm(server+"/oauth/device/code", url.Values{})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("server returned HTTP %d", resp.StatusCode)
+	}
+
+	var dc deviceCodeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&dc); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &dc, nil
+}
+
+func pollDeviceToken(httpClient *http.Client, server, deviceCode string) (*deviceTokenResponse, error) {
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {deviceCode},
+	}
+
+	resp, err := httpClient.PostForm(server+"/oauth/device/token", form)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tok deviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &tok, nil
+}
+
+// refreshToken exchanges a refresh token for a fresh access token.
+func refreshToken(server, refresh string) (*deviceTokenResponse, error) {
+	server = normalizeServer(server)
+	httpClient := &http.Client{Timeout: 10 * time.Second}
+
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refresh},
+	}
+
+	resp, err := httpClient.PostForm(server+"/oauth/token", form)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("server returned HTTP %d", resp.StatusCode)
+	}
+
+	var tok deviceTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tok); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if tok.Error != "" {
+		return nil, fmt.Errorf("refresh failed: %s", tok.Error)
+	}
+	return &tok, nil
+}
+
+// normalizeServer strips a trailing slash so server+"/oauth/..." never
+// double-slashes.
+func normalizeServer(server string) string {
+	return strings.TrimSuffix(server, "/")
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/zalando/go-keyring"
+)
+
+const secretsKeyringService = "custom-cicd-cli-secrets"
+
+// SecretStore persists named pipeline secrets for a server, using the same
+// keyring-with-file-fallback strategy as Store. It backs the
+// `${{ secrets.Y }}` interpolation in pipelinespec. There's no `cicd
+// secrets set` yet, so today entries are populated by editing the
+// fallback file directly; see Store for why the keyring is preferred.
+type SecretStore struct {
+	server string
+}
+
+// NewSecretStore returns a SecretStore scoped to server (the API base URL).
+func NewSecretStore(server string) *SecretStore {
+	return &SecretStore{server: normalizeServer(server)}
+}
+
+// Get returns the named secret's value, and whether it was found.
+func (s *SecretStore) Get(name string) (string, bool, error) {
+	key := s.server + ":" + name
+
+	if value, err := keyring.Get(secretsKeyringService, key); err == nil {
+		return value, true, nil
+	} else if err != keyring.ErrNotFound {
+		return "", false, fmt.Errorf("failed to read secret %q: %w", name, err)
+	}
+
+	all, err := s.readFallbackFile()
+	if err != nil {
+		return "", false, err
+	}
+	value, ok := all[name]
+	return value, ok, nil
+}
+
+// Set stores value under name, preferring the OS keyring and falling back
+// to a local file if no keyring backend is available.
+func (s *SecretStore) Set(name, value string) error {
+	key := s.server + ":" + name
+
+	if err := keyring.Set(secretsKeyringService, key, value); err == nil {
+		return nil
+	}
+
+	all, err := s.readFallbackFile()
+	if err != nil {
+		return err
+	}
+	if all == nil {
+		all = make(map[string]string)
+	}
+	all[name] = value
+	return s.writeFallbackFile(all)
+}
+
+// Delete removes the named secret, if any.
+func (s *SecretStore) Delete(name string) error {
+	key := s.server + ":" + name
+
+	if err := keyring.Delete(secretsKeyringService, key); err != nil && err != keyring.ErrNotFound {
+		return fmt.Errorf("failed to delete secret %q: %w", name, err)
+	}
+
+	all, err := s.readFallbackFile()
+	if err != nil {
+		return err
+	}
+	if all == nil {
+		return nil
+	}
+	delete(all, name)
+	return s.writeFallbackFile(all)
+}
+
+func (s *SecretStore) fallbackPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".custom-cicd", "secrets.json"), nil
+}
+
+func (s *SecretStore) readFallbackFile() (map[string]string, error) {
+	path, err := s.fallbackPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read secrets file: %w", err)
+	}
+
+	var allServers map[string]map[string]string
+	if err := json.Unmarshal(data, &allServers); err != nil {
+		return nil, fmt.Errorf("failed to parse secrets file: %w", err)
+	}
+	return allServers[s.server], nil
+}
+
+func (s *SecretStore) writeFallbackFile(secrets map[string]string) error {
+	path, err := s.fallbackPath()
+	if err != nil {
+		return err
+	}
+
+	allServers := make(map[string]map[string]string)
+	if data, err := os.ReadFile(path); err == nil {
+		_ = json.Unmarshal(data, &allServers)
+	}
+	allServers[s.server] = secrets
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(allServers, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode secrets: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write secrets file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Package auth manages per-host CLI credentials obtained via the OAuth 2.0
+// device authorization grant, and implements the client.TokenSource
+// interface so internal/client can attach and refresh them transparently.
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zalando/go-keyring"
+)
+
+const keyringService = "custom-cicd-cli"
+
+// Credentials holds the tokens issued for a single server.
+type Credentials struct {
+	Server       string    `json:"server"`
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// Expired reports whether the access token is at or past its expiry.
+func (c *Credentials) Expired() bool {
+	return !c.ExpiresAt.IsZero() && !time.Now().Before(c.ExpiresAt)
+}
+
+// Store persists Credentials for a server, preferring the OS keyring and
+// falling back to a 0600 file under ~/.custom-cicd when no keyring is
+// available (e.g. headless CI containers).
+type Store struct {
+	server string
+}
+
+// NewStore returns a Store scoped to server (the API base URL), since a
+// user may be logged into more than one backend. server is normalized the
+// same way NewStoredTokenSource and NewSecretStore are, so a trailing
+// slash on --server/api-url doesn't key the keyring/file store differently
+// depending on which of those constructed it.
+func NewStore(server string) *Store {
+	return &Store{server: normalizeServer(server)}
+}
+
+// Get loads the stored credentials for the store's server, if any.
+func (s *Store) Get() (*Credentials, error) {
+	data, err := keyring.Get(keyringService, s.server)
+	if err == nil {
+		var creds Credentials
+		if err := json.Unmarshal([]byte(data), &creds); err != nil {
+			return nil, fmt.Errorf("failed to parse stored credentials: %w", err)
+		}
+		return &creds, nil
+	}
+
+	return s.readFallback()
+}
+
+// Set stores creds, preferring the OS keyring and falling back to a local
+// file if no keyring backend is available.
+func (s *Store) Set(creds *Credentials) error {
+	data, err := json.Marshal(creds)
+	if err != nil {
+		return fmt.Errorf("failed to encode credentials: %w", err)
+	}
+
+	if err := keyring.Set(keyringService, s.server, string(data)); err == nil {
+		return nil
+	}
+
+	return s.writeFallback(data)
+}
+
+// Delete removes any stored credentials for the store's server.
+func (s *Store) Delete() error {
+	if err := keyring.Delete(keyringService, s.server); err != nil && err != keyring.ErrNotFound {
+		return fmt.Errorf("failed to delete keyring entry: %w", err)
+	}
+
+	all, err := s.readFallbackFile()
+	if err != nil {
+		return err
+	}
+	if all == nil {
+		return nil
+	}
+	delete(all, s.server)
+	return s.writeFallbackFile(all)
+}
+
+func (s *Store) fallbackPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".custom-cicd", "credentials.json"), nil
+}
+
+func (s *Store) readFallback() (*Credentials, error) {
+	all, err := s.readFallbackFile()
+	if err != nil {
+		return nil, err
+	}
+	if all == nil {
+		return nil, nil
+	}
+	creds, ok := all[s.server]
+	if !ok {
+		return nil, nil
+	}
+	return &creds, nil
+}
+
+func (s *Store) readFallbackFile() (map[string]Credentials, error) {
+	path, err := s.fallbackPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read credentials file: %w", err)
+	}
+
+	var all map[string]Credentials
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file: %w", err)
+	}
+	return all, nil
+}
+
+func (s *Store) writeFallback(data []byte) error {
+	var creds Credentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return fmt.Errorf("failed to parse credentials: %w", err)
+	}
+
+	all, err := s.readFallbackFile()
+	if err != nil {
+		return err
+	}
+	if all == nil {
+		all = make(map[string]Credentials)
+	}
+	all[s.server] = creds
+	return s.writeFallbackFile(all)
+}
+
+func (s *Store) writeFallbackFile(all map[string]Credentials) error {
+	path, err := s.fallbackPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode credentials: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write credentials file: %w", err)
+	}
+	return nil
+}
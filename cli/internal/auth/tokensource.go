@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"fmt"
+	"time"
+)
+
+// StoredTokenSource implements client.TokenSource on top of a Store,
+// transparently refreshing the access token via the OAuth refresh grant
+// and persisting the result.
+type StoredTokenSource struct {
+	store  *Store
+	server string
+}
+
+// NewStoredTokenSource returns a TokenSource backed by the credentials
+// stored for server.
+func NewStoredTokenSource(server string) *StoredTokenSource {
+	server = normalizeServer(server)
+	return &StoredTokenSource{store: NewStore(server), server: server}
+}
+
+// Token returns the current access token, refreshing it first if expired.
+func (s *StoredTokenSource) Token() (string, error) {
+	creds, err := s.store.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to load credentials: %w", err)
+	}
+	if creds == nil {
+		return "", fmt.Errorf("not logged in to %s; run 'cicd login'", s.server)
+	}
+	if !creds.Expired() {
+		return creds.AccessToken, nil
+	}
+
+	return s.Refresh()
+}
+
+// Refresh exchanges the stored refresh token for a new access token and
+// persists it.
+func (s *StoredTokenSource) Refresh() (string, error) {
+	creds, err := s.store.Get()
+	if err != nil {
+		return "", fmt.Errorf("failed to load credentials: %w", err)
+	}
+	if creds == nil || creds.RefreshToken == "" {
+		return "", fmt.Errorf("not logged in to %s; run 'cicd login'", s.server)
+	}
+
+	tok, err := refreshToken(s.server, creds.RefreshToken)
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh token: %w", err)
+	}
+
+	creds.AccessToken = tok.AccessToken
+	if tok.RefreshToken != "" {
+		creds.RefreshToken = tok.RefreshToken
+	}
+	creds.ExpiresAt = time.Now().Add(time.Duration(tok.ExpiresIn) * time.Second)
+
+	return creds.AccessToken, s.store.Set(creds)
+}
+
+// StaticTokenSource implements client.TokenSource for a fixed bearer token
+// (config's "bearer" auth-mode) that has no refresh flow of its own.
+type StaticTokenSource struct {
+	token string
+}
+
+// NewStaticTokenSource returns a TokenSource that always returns token.
+func NewStaticTokenSource(token string) *StaticTokenSource {
+	return &StaticTokenSource{token: token}
+}
+
+func (s *StaticTokenSource) Token() (string, error) { return s.token, nil }
+
+func (s *StaticTokenSource) Refresh() (string, error) {
+	return "", fmt.Errorf("bearer token auth does not support refresh; run 'cicd login' again")
+}
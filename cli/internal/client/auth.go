@@ -0,0 +1,16 @@
+package client
+
+// TokenSource supplies the bearer token doRequest attaches to outgoing
+// requests. When a request comes back 401, doRequest calls Refresh once and
+// retries before giving up, so a short-lived access token can be renewed
+// transparently mid-command.
+type TokenSource interface {
+	Token() (string, error)
+	Refresh() (string, error)
+}
+
+// WithAuth attaches a TokenSource so every request carries an
+// "Authorization: Bearer" header, refreshed automatically on a 401.
+func WithAuth(ts TokenSource) ClientOption {
+	return func(c *Client) { c.auth = ts }
+}
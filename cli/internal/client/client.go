@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -14,37 +15,88 @@ import (
 type Client struct {
 	BaseURL    string
 	HTTPClient *http.Client
+
+	// streamClient is used for long-lived log streaming connections, which
+	// must not be cut off by the short timeout used for regular requests.
+	streamClient *http.Client
+
+	maxRetries  int
+	backoffBase time.Duration
+	backoffMax  time.Duration
+	idempotent  bool
+
+	auth TokenSource
+}
+
+// ClientOption configures optional Client behavior. See WithHTTPClient,
+// WithRetries, WithBackoff, and WithIdempotency.
+type ClientOption func(*Client)
+
+// WithHTTPClient overrides the HTTP client used for regular (non-streaming)
+// requests.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(c *Client) { c.HTTPClient = hc }
+}
+
+// WithRetries sets how many additional attempts doRequest makes for
+// retryable failures (5xx, 429, connection resets). 0 disables retries.
+func WithRetries(n int) ClientOption {
+	return func(c *Client) { c.maxRetries = n }
+}
+
+// WithBackoff sets the base and max delay used between retries. Each retry
+// doubles the previous delay, capped at max, plus jitter.
+func WithBackoff(base, max time.Duration) ClientOption {
+	return func(c *Client) { c.backoffBase, c.backoffMax = base, max }
+}
+
+// WithIdempotency controls whether POST/DELETE calls send an
+// Idempotency-Key header, so retrying a request after a network error
+// can't double-trigger it on the server. Enabled by default.
+func WithIdempotency(enabled bool) ClientOption {
+	return func(c *Client) { c.idempotent = enabled }
 }
 
 // NewClient creates a new API client
-func NewClient(baseURL string) *Client {
-	return &Client{
+func NewClient(baseURL string, opts ...ClientOption) *Client {
+	c := &Client{
 		BaseURL: baseURL,
 		HTTPClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
+		streamClient: &http.Client{},
+		maxRetries:   3,
+		backoffBase:  250 * time.Millisecond,
+		backoffMax:   5 * time.Second,
+		idempotent:   true,
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
 // Pipeline represents a pipeline configuration
 type Pipeline struct {
-	ID         string   `json:"id,omitempty"`
-	Name       string   `json:"name"`
-	Steps      []Step   `json:"steps"`
-	Status     string   `json:"status,omitempty"`
-	CreatedAt  string   `json:"created_at,omitempty"`
-	StartedAt  *string  `json:"started_at,omitempty"`
-	FinishedAt *string  `json:"finished_at,omitempty"`
-	Duration   *float64 `json:"total_duration,omitempty"`
+	ID         string   `json:"id,omitempty" yaml:"id,omitempty"`
+	Name       string   `json:"name" yaml:"name"`
+	Steps      []Step   `json:"steps" yaml:"steps"`
+	Status     string   `json:"status,omitempty" yaml:"status,omitempty"`
+	CreatedAt  string   `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	StartedAt  *string  `json:"started_at,omitempty" yaml:"started_at,omitempty"`
+	FinishedAt *string  `json:"finished_at,omitempty" yaml:"finished_at,omitempty"`
+	Duration   *float64 `json:"total_duration,omitempty" yaml:"total_duration,omitempty"`
 }
 
 // Step represents a pipeline step
 type Step struct {
-	Name    string `json:"name"`
-	Command string `json:"command"`
-	Status  string `json:"status,omitempty"`
-	Output  string `json:"output,omitempty"`
-	Error   string `json:"error,omitempty"`
+	Name    string `json:"name" yaml:"name"`
+	Command string `json:"command" yaml:"command"`
+	Status  string `json:"status,omitempty" yaml:"status,omitempty"`
+	Output  string `json:"output,omitempty" yaml:"output,omitempty"`
+	Error   string `json:"error,omitempty" yaml:"error,omitempty"`
 }
 
 // Run represents a pipeline run
@@ -98,70 +150,169 @@ type APIResponse struct {
 	Error   string      `json:"error,omitempty"`
 }
 
-// doRequest performs an HTTP request and handles the response
-func (c *Client) doRequest(method, endpoint string, body interface{}, response interface{}) error {
-	url := c.BaseURL + endpoint
+// doRequest performs an HTTP request and handles the response. Requests of
+// every method are retried with exponential backoff and jitter on
+// 5xx/429/connection-reset failures, honoring Retry-After; mutating methods
+// (POST/DELETE) are also sent with an Idempotency-Key so a retried request
+// can't double-trigger it on the server. Retries stop as soon as the request's context is cancelled.
+func (c *Client) doRequest(method, endpoint string, body interface{}, response interface{}, opts ...RequestOption) error {
+	options := newRequestOptions(opts)
 
-	var reqBody io.Reader
+	var jsonData []byte
 	if body != nil {
-		jsonData, err := json.Marshal(body)
+		var err error
+		jsonData, err = json.Marshal(body)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewBuffer(jsonData)
 	}
 
-	req, err := http.NewRequest(method, url, reqBody)
+	idempotencyKey := options.idempotencyKey
+	if idempotencyKey == "" && c.idempotent && (method == http.MethodPost || method == http.MethodDelete) {
+		idempotencyKey = generateIdempotencyKey()
+	}
+
+	var lastErr error
+	refreshedAuth := false
+	skipDelay := false
+
+	for attempt := 0; ; {
+		if attempt > c.maxRetries {
+			return lastErr.(retryableError).err
+		}
+		if attempt > 0 && !skipDelay {
+			select {
+			case <-time.After(lastErr.(retryableError).delay):
+			case <-options.ctx.Done():
+				return options.ctx.Err()
+			}
+		}
+		skipDelay = false
+
+		var token string
+		if c.auth != nil {
+			var err error
+			token, err = c.auth.Token()
+			if err != nil {
+				return fmt.Errorf("failed to get auth token: %w", err)
+			}
+		}
+
+		resp, respBody, err := c.attemptRequest(options.ctx, method, endpoint, jsonData, idempotencyKey, token)
+		if err != nil {
+			if attempt == c.maxRetries {
+				return err
+			}
+			lastErr = retryableError{err: err, delay: c.retryDelay(attempt+1, "")}
+			attempt++
+			continue
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && c.auth != nil && !refreshedAuth {
+			if _, rerr := c.auth.Refresh(); rerr == nil {
+				refreshedAuth = true
+				skipDelay = true
+				continue // retry immediately with the refreshed token, no backoff
+			}
+		}
+
+		if isRetryableStatus(resp.StatusCode) && attempt < c.maxRetries {
+			lastErr = retryableError{
+				err:   fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody)),
+				delay: c.retryDelay(attempt+1, resp.Header.Get("Retry-After")),
+			}
+			attempt++
+			continue
+		}
+
+		return c.decodeResponse(resp.StatusCode, respBody, response)
+	}
+}
+
+// retryableError pairs a failure with the backoff to wait before retrying
+// it, so the retry loop above doesn't need to recompute it.
+type retryableError struct {
+	err   error
+	delay time.Duration
+}
+
+func (e retryableError) Error() string { return e.err.Error() }
+
+// attemptRequest sends a single HTTP request and returns the response and
+// its fully-read body.
+func (c *Client) attemptRequest(ctx context.Context, method, endpoint string, jsonData []byte, idempotencyKey, token string) (*http.Response, []byte, error) {
+	url := c.BaseURL + endpoint
+
+	var reqBody io.Reader
+	if jsonData != nil {
+		reqBody = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	if body != nil {
+	if jsonData != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if resp.StatusCode >= 400 {
+	return resp, respBody, nil
+}
+
+// decodeResponse translates a completed response into an error (for
+// non-2xx statuses) or decodes it into response.
+func (c *Client) decodeResponse(statusCode int, respBody []byte, response interface{}) error {
+	if statusCode >= 400 {
 		var errorResp ErrorResponse
 		if err := json.Unmarshal(respBody, &errorResp); err != nil {
-			return fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(respBody))
+			return fmt.Errorf("HTTP %d: %s", statusCode, string(respBody))
 		}
 		return fmt.Errorf("API error: %s", errorResp.Error)
 	}
 
-	if response != nil {
-		// Try to unmarshal as wrapped API response first
-		var apiResp APIResponse
-		if err := json.Unmarshal(respBody, &apiResp); err == nil {
-			if !apiResp.Success {
-				return fmt.Errorf("API error: %s", apiResp.Error)
-			}
-			// Marshal the data field and unmarshal into the target response
-			dataBytes, err := json.Marshal(apiResp.Data)
-			if err != nil {
-				return fmt.Errorf("failed to marshal API data: %w", err)
-			}
-			if err := json.Unmarshal(dataBytes, response); err != nil {
-				return fmt.Errorf("failed to unmarshal API data: %w", err)
-			}
-		} else {
-			// Fallback to direct unmarshaling for non-wrapped responses
-			if err := json.Unmarshal(respBody, response); err != nil {
-				return fmt.Errorf("failed to unmarshal response: %w", err)
-			}
+	if response == nil {
+		return nil
+	}
+
+	// Try to unmarshal as wrapped API response first
+	var apiResp APIResponse
+	if err := json.Unmarshal(respBody, &apiResp); err == nil {
+		if !apiResp.Success {
+			return fmt.Errorf("API error: %s", apiResp.Error)
+		}
+		// Marshal the data field and unmarshal into the target response
+		dataBytes, err := json.Marshal(apiResp.Data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal API data: %w", err)
 		}
+		if err := json.Unmarshal(dataBytes, response); err != nil {
+			return fmt.Errorf("failed to unmarshal API data: %w", err)
+		}
+		return nil
 	}
 
+	// Fallback to direct unmarshaling for non-wrapped responses
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return fmt.Errorf("failed to unmarshal response: %w", err)
+	}
 	return nil
 }
 
@@ -251,3 +402,26 @@ func (c *Client) DeleteRun(runID string) error {
 	endpoint := fmt.Sprintf("/api/runs/%s", runID)
 	return c.doRequest("DELETE", endpoint, nil, nil)
 }
+
+// RetryRunOptions controls which steps a retry re-executes.
+type RetryRunOptions struct {
+	FromStep   string `json:"from_step,omitempty"`
+	OnlyFailed bool   `json:"only_failed"`
+}
+
+// RetryRunResponse represents the response from retrying a run.
+type RetryRunResponse struct {
+	RunID  string `json:"run_id"`
+	Status string `json:"status"`
+}
+
+// RetryRun asks the backend to produce a new run that reuses the prior run's
+// successful step outputs, re-executing only the steps opts selects: failed
+// or cancelled steps by default, everything from opts.FromStep onward when
+// set, or every step when opts.OnlyFailed is false.
+func (c *Client) RetryRun(runID string, opts RetryRunOptions) (*RetryRunResponse, error) {
+	var response RetryRunResponse
+	endpoint := fmt.Sprintf("/api/runs/%s/retry", runID)
+	err := c.doRequest("POST", endpoint, opts, &response)
+	return &response, err
+}
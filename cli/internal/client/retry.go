@@ -0,0 +1,85 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// requestOptions holds the per-call settings applied by RequestOption.
+type requestOptions struct {
+	ctx            context.Context
+	idempotencyKey string
+}
+
+// RequestOption configures a single doRequest call, layered on top of the
+// Client-wide ClientOption defaults.
+type RequestOption func(*requestOptions)
+
+// WithContext binds a doRequest call (and its retries) to ctx. The exported
+// Client methods (CreatePipeline, RunPipeline, etc.) take no RequestOption,
+// so their requests always run under context.Background().
+func WithContext(ctx context.Context) RequestOption {
+	return func(o *requestOptions) { o.ctx = ctx }
+}
+
+// WithIdempotencyKey sets an explicit Idempotency-Key for a doRequest call
+// instead of letting doRequest generate one. The exported Client methods
+// take no RequestOption, so their POST/DELETE requests always use a
+// generated key when idempotency is enabled.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) { o.idempotencyKey = key }
+}
+
+func newRequestOptions(opts []RequestOption) requestOptions {
+	o := requestOptions{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// generateIdempotencyKey returns a random hex token suitable for an
+// Idempotency-Key header.
+func generateIdempotencyKey() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		// crypto/rand failing is effectively unheard of; fall back to a
+		// weaker but still-unique source rather than leaving the key empty.
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(buf)
+}
+
+// isRetryableStatus reports whether resp's status code is worth retrying.
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryDelay computes the backoff before retry attempt n (1-indexed),
+// honoring a Retry-After header when present, and otherwise doubling
+// backoffBase up to backoffMax with up to 20% jitter.
+func (c *Client) retryDelay(n int, retryAfter string) time.Duration {
+	if retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(seconds) * time.Second
+		}
+		if when, err := http.ParseTime(retryAfter); err == nil {
+			if d := time.Until(when); d > 0 {
+				return d
+			}
+		}
+	}
+
+	delay := time.Duration(float64(c.backoffBase) * math.Pow(2, float64(n-1)))
+	if delay > c.backoffMax {
+		delay = c.backoffMax
+	}
+	jitter := time.Duration(mathrand.Int63n(int64(delay)/5 + 1))
+	return delay + jitter
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	c := NewClient("https://example.test", WithBackoff(100*time.Millisecond, time.Second))
+
+	t.Run("honors Retry-After in seconds", func(t *testing.T) {
+		d := c.retryDelay(1, "2")
+		if d != 2*time.Second {
+			t.Fatalf("retryDelay(1, \"2\") = %v, want 2s", d)
+		}
+	})
+
+	t.Run("honors Retry-After as an HTTP date", func(t *testing.T) {
+		when := time.Now().Add(3 * time.Second)
+		d := c.retryDelay(1, when.UTC().Format(http.TimeFormat))
+		if d <= 0 || d > 3*time.Second {
+			t.Fatalf("retryDelay with future Retry-After date = %v, want roughly <=3s and >0", d)
+		}
+	})
+
+	t.Run("ignores an unparsable Retry-After", func(t *testing.T) {
+		d := c.retryDelay(1, "not-a-value")
+		if d < 100*time.Millisecond || d > 120*time.Millisecond {
+			t.Fatalf("retryDelay(1, \"not-a-value\") = %v, want ~100-120ms backoff+jitter", d)
+		}
+	})
+
+	t.Run("doubles each attempt", func(t *testing.T) {
+		d1 := c.retryDelay(1, "")
+		d2 := c.retryDelay(2, "")
+		if d1 < 100*time.Millisecond || d1 > 120*time.Millisecond {
+			t.Fatalf("retryDelay(1, \"\") = %v, want ~100-120ms", d1)
+		}
+		if d2 < 200*time.Millisecond || d2 > 240*time.Millisecond {
+			t.Fatalf("retryDelay(2, \"\") = %v, want ~200-240ms", d2)
+		}
+	})
+
+	t.Run("caps at backoffMax", func(t *testing.T) {
+		capped := NewClient("https://example.test", WithBackoff(300*time.Millisecond, time.Second))
+		d := capped.retryDelay(3, "") // uncapped would be 300ms * 2^2 = 1.2s
+		if d < time.Second || d > time.Second+200*time.Millisecond {
+			t.Fatalf("retryDelay(3, \"\") = %v, want capped near backoffMax (1s) plus jitter", d)
+		}
+	})
+}
@@ -0,0 +1,163 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LogEventType identifies the kind of event delivered by StreamRunLogs.
+type LogEventType string
+
+const (
+	// LogEventLine carries a single line of step output.
+	LogEventLine LogEventType = "log"
+	// LogEventStepStarted marks a step beginning execution.
+	LogEventStepStarted LogEventType = "step_started"
+	// LogEventStepFinished marks a step reaching a terminal status.
+	LogEventStepFinished LogEventType = "step_finished"
+	// LogEventRunFinished marks the run itself reaching a terminal status;
+	// it is always the last event on the stream.
+	LogEventRunFinished LogEventType = "run_finished"
+)
+
+// LogEvent is a single event delivered while streaming a run's logs: either
+// a line of step output or a step/run boundary marker.
+type LogEvent struct {
+	Type      LogEventType `json:"type"`
+	StepName  string       `json:"step_name,omitempty"`
+	Stream    string       `json:"stream,omitempty"` // "stdout" or "stderr"
+	Timestamp time.Time    `json:"timestamp"`
+	Line      string       `json:"line,omitempty"`
+	Status    string       `json:"status,omitempty"` // set on step_finished/run_finished
+}
+
+// StreamOptions configures a StreamRunLogs call.
+type StreamOptions struct {
+	// Since replays events produced within this duration before now. Zero
+	// means start from the live tail only.
+	Since time.Duration
+	// Tail replays up to this many of the most recent lines before
+	// following live output. Zero means no backlog replay.
+	Tail int
+}
+
+// StreamRunLogs opens a persistent connection to the backend's log stream
+// for runID and delivers LogEvents as they're produced. The returned
+// channel is closed when the run reaches a terminal status or ctx is
+// cancelled. Dropped connections are retried with backoff and resume from
+// the last delivered event's offset, so no lines are skipped or repeated.
+func (c *Client) StreamRunLogs(ctx context.Context, runID string, opts StreamOptions) (<-chan LogEvent, error) {
+	ch := make(chan LogEvent)
+
+	go func() {
+		defer close(ch)
+
+		backoff := time.Second
+		const maxBackoff = 15 * time.Second
+		offset := ""
+
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+
+			nextOffset, done, err := c.streamRunLogsOnce(ctx, runID, opts, offset, ch)
+			offset = nextOffset
+			if done {
+				return
+			}
+			if err != nil {
+				select {
+				case <-time.After(backoff):
+				case <-ctx.Done():
+					return
+				}
+				backoff *= 2
+				if backoff > maxBackoff {
+					backoff = maxBackoff
+				}
+				continue
+			}
+			backoff = time.Second
+		}
+	}()
+
+	return ch, nil
+}
+
+// streamRunLogsOnce opens a single SSE connection, forwards events, and
+// tracks the offset of the last event seen so a reconnect can resume via
+// Last-Event-ID instead of replaying the whole backlog.
+func (c *Client) streamRunLogsOnce(ctx context.Context, runID string, opts StreamOptions, resumeFrom string, ch chan<- LogEvent) (offset string, done bool, err error) {
+	endpoint := fmt.Sprintf("%s/api/runs/%s/logs/stream", c.BaseURL, runID)
+
+	query := url.Values{}
+	if opts.Since > 0 {
+		query.Set("since", strconv.Itoa(int(opts.Since.Seconds())))
+	}
+	if opts.Tail > 0 {
+		query.Set("tail", strconv.Itoa(opts.Tail))
+	}
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return resumeFrom, false, fmt.Errorf("failed to create stream request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if resumeFrom != "" {
+		req.Header.Set("Last-Event-ID", resumeFrom)
+	}
+
+	resp, err := c.streamClient.Do(req)
+	if err != nil {
+		return resumeFrom, false, fmt.Errorf("stream request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return resumeFrom, false, fmt.Errorf("stream returned HTTP %d", resp.StatusCode)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		data := strings.TrimPrefix(scanner.Text(), "data: ")
+		if data == "" {
+			continue
+		}
+		if data == "[DONE]" {
+			return resumeFrom, true, nil
+		}
+
+		var event LogEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			continue
+		}
+		resumeFrom = event.Timestamp.Format(time.RFC3339Nano)
+
+		select {
+		case ch <- event:
+		case <-ctx.Done():
+			return resumeFrom, false, nil
+		}
+
+		if event.Type == LogEventRunFinished {
+			return resumeFrom, true, nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return resumeFrom, false, fmt.Errorf("stream read failed: %w", err)
+	}
+
+	return resumeFrom, false, nil
+}
@@ -10,13 +10,15 @@ import (
 
 // Config holds the application configuration
 type Config struct {
-	APIURL string `mapstructure:"api_url"`
+	APIURL   string `mapstructure:"api_url"`
+	AuthMode string `mapstructure:"auth_mode"`
 }
 
 // DefaultConfig returns the default configuration
 func DefaultConfig() *Config {
 	return &Config{
-		APIURL: "http://localhost:80", // Point to gateway by default
+		APIURL:   "http://localhost:80", // Point to gateway by default
+		AuthMode: "none",
 	}
 }
 
@@ -39,6 +41,7 @@ func LoadConfig() (*Config, error) {
 
 	// Set defaults
 	viper.SetDefault("api_url", "http://localhost:80")
+	viper.SetDefault("auth_mode", "none")
 
 	// Read config file
 	if err := viper.ReadInConfig(); err != nil {
@@ -68,6 +71,7 @@ func SaveConfig(config *Config) error {
 	}
 
 	viper.Set("api_url", config.APIURL)
+	viper.Set("auth_mode", config.AuthMode)
 
 	configFile := filepath.Join(configDir, "config.yaml")
 	if err := viper.WriteConfigAs(configFile); err != nil {
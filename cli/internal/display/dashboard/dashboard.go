@@ -0,0 +1,448 @@
+// Package dashboard implements the bubbletea TUI model behind `cicd
+// dashboard`, the split-pane replacement for the old ANSI-clear polling
+// loop used by `pipeline monitor` / `monitor`. It is kept separate from
+// internal/display so the plain Print* functions used by non-TUI commands
+// stay free of TUI dependencies.
+package dashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"custom-cicd-cli/internal/client"
+	"custom-cicd-cli/internal/display"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const refreshInterval = 3 * time.Second
+
+var (
+	headerStyle    = lipgloss.NewStyle().Bold(true).Underline(true)
+	selectedStyle  = lipgloss.NewStyle().Reverse(true)
+	paneStyle      = lipgloss.NewStyle().Padding(0, 1)
+	statusMsgStyle = lipgloss.NewStyle().Italic(true)
+)
+
+// item is a single row in the left-hand pipelines/runs table.
+type item struct {
+	kind   string // "pipeline" or "run"
+	id     string
+	name   string
+	status string
+}
+
+func (it item) emoji() string {
+	if e := display.StatusEmojis[it.status]; e != "" {
+		return e
+	}
+	return "❓"
+}
+
+// Model is the bubbletea model backing `cicd dashboard`.
+type Model struct {
+	apiClient *client.Client
+
+	items     []item
+	cursor    int
+	filter    string
+	filtering bool
+
+	detail    *client.Run
+	detailErr error
+	logLines  []client.LogEvent
+	logCh     <-chan client.LogEvent
+
+	// streamCancel stops the in-flight StreamRunLogs goroutine for the
+	// previously selected item; streamGen tags every message that
+	// goroutine produces so a stale one delivered during the cancellation
+	// race gets dropped instead of appended to the newly selected item's
+	// logLines.
+	streamCancel context.CancelFunc
+	streamGen    int
+
+	confirmDelete bool
+	statusMsg     string
+
+	width, height int
+	quitting      bool
+}
+
+// New creates a dashboard Model bound to the given API client.
+func New(c *client.Client) Model {
+	return Model{apiClient: c}
+}
+
+type itemsLoadedMsg struct {
+	items []item
+	err   error
+}
+
+type detailLoadedMsg struct {
+	run *client.Run
+	err error
+}
+
+type logChReadyMsg struct {
+	ch  <-chan client.LogEvent
+	err error
+	gen int
+}
+type logLineMsg struct {
+	event client.LogEvent
+	gen   int
+}
+type logClosedMsg struct{ gen int }
+type tickMsg time.Time
+type actionDoneMsg struct {
+	verb string
+	err  error
+}
+
+func (m Model) Init() tea.Cmd {
+	return tea.Batch(m.loadItems(), tick())
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(refreshInterval, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+// loadItems refreshes the pipeline/run table with one ListPipelines and one
+// ListRuns call; the dashboard polls this way on every tick and does not
+// subscribe to a combined backend event stream.
+func (m Model) loadItems() tea.Cmd {
+	return func() tea.Msg {
+		pipelines, err := m.apiClient.ListPipelines()
+		if err != nil {
+			return itemsLoadedMsg{err: err}
+		}
+		runs, err := m.apiClient.ListRuns("")
+		if err != nil {
+			return itemsLoadedMsg{err: err}
+		}
+
+		items := make([]item, 0, len(pipelines)+len(runs))
+		for _, p := range pipelines {
+			items = append(items, item{kind: "pipeline", id: p.ID, name: p.Name, status: p.Status})
+		}
+		for _, r := range runs {
+			items = append(items, item{kind: "run", id: r.ID, name: r.Name, status: r.Status})
+		}
+		return itemsLoadedMsg{items: items}
+	}
+}
+
+func (m Model) loadDetail(it item) tea.Cmd {
+	return func() tea.Msg {
+		if it.kind == "pipeline" {
+			pipeline, err := m.apiClient.GetPipeline(it.id)
+			if err != nil {
+				return detailLoadedMsg{err: err}
+			}
+			return detailLoadedMsg{run: &client.Run{
+				ID: pipeline.ID, Name: pipeline.Name, Status: pipeline.Status, Steps: pipeline.Steps,
+			}}
+		}
+		run, err := m.apiClient.GetRun(it.id)
+		return detailLoadedMsg{run: run, err: err}
+	}
+}
+
+// streamLogs cancels any stream still running for a previously selected
+// item and opens a new one for runID, tagged with a fresh generation so
+// messages from the cancelled stream can't be mistaken for this one.
+func (m *Model) streamLogs(runID string) tea.Cmd {
+	if m.streamCancel != nil {
+		m.streamCancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.streamCancel = cancel
+	m.streamGen++
+	gen := m.streamGen
+
+	return func() tea.Msg {
+		ch, err := m.apiClient.StreamRunLogs(ctx, runID, client.StreamOptions{Tail: 50})
+		return logChReadyMsg{ch: ch, err: err, gen: gen}
+	}
+}
+
+// waitForNextLog reads the next line off an already-open stream. Re-issuing
+// this command after each logLineMsg is what keeps the channel draining
+// without blocking bubbletea's Update loop.
+func waitForNextLog(ch <-chan client.LogEvent, gen int) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-ch
+		if !ok {
+			return logClosedMsg{gen: gen}
+		}
+		return logLineMsg{event: line, gen: gen}
+	}
+}
+
+func (m Model) visibleItems() []item {
+	if m.filter == "" {
+		return m.items
+	}
+	var out []item
+	for _, it := range m.items {
+		if strings.Contains(strings.ToLower(it.name), strings.ToLower(m.filter)) {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+func (m Model) selected() (item, bool) {
+	visible := m.visibleItems()
+	if m.cursor < 0 || m.cursor >= len(visible) {
+		return item{}, false
+	}
+	return visible[m.cursor], true
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case itemsLoadedMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("refresh failed: %v", msg.err)
+			return m, nil
+		}
+		m.items = msg.items
+		return m, nil
+
+	case tickMsg:
+		return m, tea.Batch(m.loadItems(), tick())
+
+	case detailLoadedMsg:
+		m.detail, m.detailErr = msg.run, msg.err
+		m.logLines, m.logCh = nil, nil
+		if msg.run == nil {
+			return m, nil
+		}
+		cmd := m.streamLogs(msg.run.ID)
+		return m, cmd
+
+	case logChReadyMsg:
+		if msg.gen != m.streamGen {
+			return m, nil // stream for a since-superseded selection
+		}
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("log stream failed: %v", msg.err)
+			return m, nil
+		}
+		m.logCh = msg.ch
+		return m, waitForNextLog(m.logCh, msg.gen)
+
+	case logLineMsg:
+		if msg.gen != m.streamGen {
+			return m, nil // event from a since-superseded selection
+		}
+		m.logLines = append(m.logLines, msg.event)
+		if len(m.logLines) > 200 {
+			m.logLines = m.logLines[len(m.logLines)-200:]
+		}
+		if m.logCh != nil {
+			return m, waitForNextLog(m.logCh, msg.gen)
+		}
+		return m, nil
+
+	case logClosedMsg:
+		if msg.gen != m.streamGen {
+			return m, nil
+		}
+		m.logCh = nil
+		return m, nil
+
+	case actionDoneMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("%s failed: %v", msg.verb, msg.err)
+		} else {
+			m.statusMsg = fmt.Sprintf("%s succeeded", msg.verb)
+		}
+		return m, m.loadItems()
+
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.filtering {
+		switch msg.String() {
+		case "enter", "esc":
+			m.filtering = false
+		case "backspace":
+			if len(m.filter) > 0 {
+				m.filter = m.filter[:len(m.filter)-1]
+			}
+		default:
+			m.filter += msg.String()
+		}
+		return m, nil
+	}
+
+	if m.confirmDelete {
+		switch msg.String() {
+		case "y":
+			m.confirmDelete = false
+			it, ok := m.selected()
+			if !ok {
+				return m, nil
+			}
+			return m, m.deleteItem(it)
+		default:
+			m.confirmDelete = false
+			return m, nil
+		}
+	}
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.visibleItems())-1 {
+			m.cursor++
+		}
+	case "/":
+		m.filtering = true
+		m.filter = ""
+	case "enter", "l":
+		it, ok := m.selected()
+		if ok {
+			return m, m.loadDetail(it)
+		}
+	case "c":
+		it, ok := m.selected()
+		if ok {
+			return m, m.cancelItem(it)
+		}
+	case "d":
+		if _, ok := m.selected(); ok {
+			m.confirmDelete = true
+		}
+	case "r":
+		it, ok := m.selected()
+		if ok && it.kind == "run" {
+			return m, m.retryItem(it)
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) cancelItem(it item) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if it.kind == "pipeline" {
+			err = m.apiClient.CancelPipeline(it.id)
+		} else {
+			err = m.apiClient.CancelRun(it.id)
+		}
+		return actionDoneMsg{verb: "cancel", err: err}
+	}
+}
+
+func (m Model) deleteItem(it item) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if it.kind == "pipeline" {
+			err = m.apiClient.DeletePipeline(it.id)
+		} else {
+			err = m.apiClient.DeleteRun(it.id)
+		}
+		return actionDoneMsg{verb: "delete", err: err}
+	}
+}
+
+func (m Model) retryItem(it item) tea.Cmd {
+	return func() tea.Msg {
+		_, err := m.apiClient.RetryRun(it.id, client.RetryRunOptions{OnlyFailed: true})
+		return actionDoneMsg{verb: "retry", err: err}
+	}
+}
+
+func (m Model) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	left := m.renderList()
+	right := m.renderDetail()
+
+	body := lipgloss.JoinHorizontal(lipgloss.Top, paneStyle.Render(left), paneStyle.Render(right))
+
+	footer := "↑/↓ select · enter/l detail · c cancel · d delete · r retry · / filter · q quit"
+	if m.filtering {
+		footer = fmt.Sprintf("filter: %s_", m.filter)
+	}
+	if m.confirmDelete {
+		footer = "delete selected item? (y/n)"
+	}
+	if m.statusMsg != "" {
+		footer = m.statusMsg + "  |  " + footer
+	}
+
+	return body + "\n" + statusMsgStyle.Render(footer)
+}
+
+func (m Model) renderList() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Pipelines & Runs") + "\n")
+
+	for i, it := range m.visibleItems() {
+		line := fmt.Sprintf("%s %-8s %s", it.emoji(), it.kind, it.name)
+		if i == m.cursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	return b.String()
+}
+
+func (m Model) renderDetail() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Detail") + "\n")
+
+	if m.detailErr != nil {
+		b.WriteString(fmt.Sprintf("error: %v\n", m.detailErr))
+		return b.String()
+	}
+	if m.detail == nil {
+		b.WriteString("select an item and press enter\n")
+		return b.String()
+	}
+
+	b.WriteString(fmt.Sprintf("%s [%s]\n", m.detail.Name, m.detail.Status))
+	for _, step := range m.detail.Steps {
+		emoji := display.StatusEmojis[step.Status]
+		if emoji == "" {
+			emoji = "❓"
+		}
+		b.WriteString(fmt.Sprintf("  %s %s\n", emoji, step.Name))
+	}
+
+	b.WriteString("\nlogs:\n")
+	for _, event := range m.logLines {
+		if event.Type != client.LogEventLine {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("  [%s] %s\n", event.StepName, event.Line))
+	}
+
+	return b.String()
+}
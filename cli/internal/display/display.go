@@ -19,23 +19,42 @@ var StatusEmojis = map[string]string{
 	"never_run": "💤",
 }
 
-// PrintPipelines displays a list of pipelines in a formatted way
+// glyph returns icon followed by a space, or "" when emoji decoration is
+// disabled via --no-emoji or NO_COLOR.
+func glyph(icon string) string {
+	if noEmoji {
+		return ""
+	}
+	return icon + " "
+}
+
+func statusGlyph(status string) string {
+	if noEmoji {
+		return ""
+	}
+	if emoji := StatusEmojis[status]; emoji != "" {
+		return emoji + " "
+	}
+	return "❓ "
+}
+
+// PrintPipelines displays a list of pipelines, honoring the configured
+// --output format.
 func PrintPipelines(pipelines []client.Pipeline) {
+	render(pipelines, func() { printPipelinesPretty(pipelines) })
+}
+
+func printPipelinesPretty(pipelines []client.Pipeline) {
 	if len(pipelines) == 0 {
-		fmt.Println("📋 No pipelines found")
+		fmt.Printf("%sNo pipelines found\n", glyph("📋"))
 		return
 	}
 
-	fmt.Printf("\n📋 Found %d pipeline(s):\n", len(pipelines))
+	fmt.Printf("\n%sFound %d pipeline(s):\n", glyph("📋"), len(pipelines))
 	fmt.Println(strings.Repeat("-", 80))
-	
-	for _, pipeline := range pipelines {
-		emoji := StatusEmojis[pipeline.Status]
-		if emoji == "" {
-			emoji = "❓"
-		}
 
-		fmt.Printf("%s %s\n", emoji, pipeline.Name)
+	for _, pipeline := range pipelines {
+		fmt.Printf("%s%s\n", statusGlyph(pipeline.Status), pipeline.Name)
 		fmt.Printf("\tID: %s\n", pipeline.ID)
 		fmt.Printf("\tStatus: %s\n", pipeline.Status)
 		fmt.Printf("\tCreated: %s\n", pipeline.CreatedAt)
@@ -49,62 +68,49 @@ func PrintPipelines(pipelines []client.Pipeline) {
 	}
 }
 
-// PrintPipelineDetails displays detailed information about a pipeline
+// PrintPipelineDetails displays detailed information about a pipeline,
+// honoring the configured --output format.
 func PrintPipelineDetails(pipeline *client.Pipeline) {
-	emoji := StatusEmojis[pipeline.Status]
-	if emoji == "" {
-		emoji = "❓"
-	}
+	render(pipeline, func() { printPipelineDetailsPretty(pipeline) })
+}
 
-	fmt.Printf("\n%s Pipeline: %s\n", emoji, pipeline.Name)
-	fmt.Printf("📋 ID: %s\n", pipeline.ID)
-	fmt.Printf("📊 Status: %s\n", pipeline.Status)
+func printPipelineDetailsPretty(pipeline *client.Pipeline) {
+	fmt.Printf("\n%sPipeline: %s\n", statusGlyph(pipeline.Status), pipeline.Name)
+	fmt.Printf("%sID: %s\n", glyph("📋"), pipeline.ID)
+	fmt.Printf("%sStatus: %s\n", glyph("📊"), pipeline.Status)
 	if pipeline.StartedAt != nil {
-		fmt.Printf("🕐 Started: %s\n", *pipeline.StartedAt)
+		fmt.Printf("%sStarted: %s\n", glyph("🕐"), *pipeline.StartedAt)
 	}
 	if pipeline.FinishedAt != nil {
-		fmt.Printf("🕐 Finished: %s\n", *pipeline.FinishedAt)
+		fmt.Printf("%sFinished: %s\n", glyph("🕐"), *pipeline.FinishedAt)
 	}
 	if pipeline.Duration != nil {
-		fmt.Printf("⏱️  Duration: %.2f seconds\n", *pipeline.Duration)
+		fmt.Printf("%sDuration: %.2f seconds\n", glyph("⏱️ "), *pipeline.Duration)
 	}
 
 	if len(pipeline.Steps) > 0 {
-		fmt.Println("\n📝 Steps:")
-		for i, step := range pipeline.Steps {
-			stepEmoji := StatusEmojis[step.Status]
-			if stepEmoji == "" {
-				stepEmoji = "❓"
-			}
-
-			fmt.Printf("  %d. %s %s [%s]\n", i+1, stepEmoji, step.Name, step.Status)
-			if step.Output != "" && strings.TrimSpace(step.Output) != "" {
-				fmt.Printf("     📤 Output: %s\n", strings.TrimSpace(step.Output))
-			}
-			if step.Error != "" && strings.TrimSpace(step.Error) != "" {
-				fmt.Printf("     🚨 Error: %s\n", strings.TrimSpace(step.Error))
-			}
-		}
+		fmt.Printf("\n%sSteps:\n", glyph("📝"))
+		printSteps(pipeline.Steps)
 	}
 }
 
-// PrintRuns displays a list of runs in a formatted way
+// PrintRuns displays a list of runs, honoring the configured --output
+// format.
 func PrintRuns(runs []client.Run) {
+	render(runs, func() { printRunsPretty(runs) })
+}
+
+func printRunsPretty(runs []client.Run) {
 	if len(runs) == 0 {
-		fmt.Println("🏃 No runs found")
+		fmt.Printf("%sNo runs found\n", glyph("🏃"))
 		return
 	}
 
-	fmt.Printf("\n🏃 Found %d run(s):\n", len(runs))
+	fmt.Printf("\n%sFound %d run(s):\n", glyph("🏃"), len(runs))
 	fmt.Println(strings.Repeat("-", 80))
 
 	for _, run := range runs {
-		emoji := StatusEmojis[run.Status]
-		if emoji == "" {
-			emoji = "❓"
-		}
-
-		fmt.Printf("%s %s\n", emoji, run.Name)
+		fmt.Printf("%s%s\n", statusGlyph(run.Status), run.Name)
 		fmt.Printf("   Run ID: %s\n", run.ID)
 		fmt.Printf("   Pipeline ID: %s\n", run.PipelineID)
 		fmt.Printf("   Status: %s\n", run.Status)
@@ -122,65 +128,65 @@ func PrintRuns(runs []client.Run) {
 	}
 }
 
-// PrintRunDetails displays detailed information about a run
+// PrintRunDetails displays detailed information about a run, honoring the
+// configured --output format.
 func PrintRunDetails(run *client.Run) {
-	emoji := StatusEmojis[run.Status]
-	if emoji == "" {
-		emoji = "❓"
-	}
+	render(run, func() { printRunDetailsPretty(run) })
+}
 
-	fmt.Printf("\n%s Run: %s\n", emoji, run.Name)
-	fmt.Printf("🏃 Run ID: %s\n", run.ID)
-	fmt.Printf("📋 Pipeline ID: %s\n", run.PipelineID)
-	fmt.Printf("📊 Status: %s\n", run.Status)
-	fmt.Printf("🕐 Created: %s\n", run.CreatedAt)
+func printRunDetailsPretty(run *client.Run) {
+	fmt.Printf("\n%sRun: %s\n", statusGlyph(run.Status), run.Name)
+	fmt.Printf("%sRun ID: %s\n", glyph("🏃"), run.ID)
+	fmt.Printf("%sPipeline ID: %s\n", glyph("📋"), run.PipelineID)
+	fmt.Printf("%sStatus: %s\n", glyph("📊"), run.Status)
+	fmt.Printf("%sCreated: %s\n", glyph("🕐"), run.CreatedAt)
 	if run.StartedAt != nil {
-		fmt.Printf("🕐 Started: %s\n", *run.StartedAt)
+		fmt.Printf("%sStarted: %s\n", glyph("🕐"), *run.StartedAt)
 	}
 	if run.FinishedAt != nil {
-		fmt.Printf("🕐 Finished: %s\n", *run.FinishedAt)
+		fmt.Printf("%sFinished: %s\n", glyph("🕐"), *run.FinishedAt)
 	}
 	if run.Duration != nil {
-		fmt.Printf("⏱️  Duration: %.2f seconds\n", *run.Duration)
+		fmt.Printf("%sDuration: %.2f seconds\n", glyph("⏱️ "), *run.Duration)
 	}
 
 	if len(run.Steps) > 0 {
-		fmt.Println("\n📝 Steps:")
-		for i, step := range run.Steps {
-			stepEmoji := StatusEmojis[step.Status]
-			if stepEmoji == "" {
-				stepEmoji = "❓"
-			}
-
-			fmt.Printf("  %d. %s %s [%s]\n", i+1, stepEmoji, step.Name, step.Status)
-			if step.Output != "" && strings.TrimSpace(step.Output) != "" {
-				fmt.Printf("     📤 Output: %s\n", strings.TrimSpace(step.Output))
-			}
-			if step.Error != "" && strings.TrimSpace(step.Error) != "" {
-				fmt.Printf("     🚨 Error: %s\n", strings.TrimSpace(step.Error))
-			}
+		fmt.Printf("\n%sSteps:\n", glyph("📝"))
+		printSteps(run.Steps)
+	}
+}
+
+// printSteps renders a step list shared by pipeline and run detail views.
+func printSteps(steps []client.Step) {
+	for i, step := range steps {
+		fmt.Printf("  %d. %s%s [%s]\n", i+1, statusGlyph(step.Status), step.Name, step.Status)
+		if strings.TrimSpace(step.Output) != "" {
+			fmt.Printf("     %sOutput: %s\n", glyph("📤"), strings.TrimSpace(step.Output))
+		}
+		if strings.TrimSpace(step.Error) != "" {
+			fmt.Printf("     %sError: %s\n", glyph("🚨"), strings.TrimSpace(step.Error))
 		}
 	}
 }
 
 // PrintSuccess prints a success message with emoji
 func PrintSuccess(message string) {
-	fmt.Printf("✅ %s\n", message)
+	fmt.Printf("%s%s\n", glyph("✅"), message)
 }
 
 // PrintError prints an error message with emoji
 func PrintError(message string) {
-	fmt.Printf("❌ %s\n", message)
+	fmt.Printf("%s%s\n", glyph("❌"), message)
 }
 
 // PrintInfo prints an info message with emoji
 func PrintInfo(message string) {
-	fmt.Printf("ℹ️  %s\n", message)
+	fmt.Printf("%s%s\n", glyph("ℹ️ "), message)
 }
 
 // PrintWarning prints a warning message with emoji
 func PrintWarning(message string) {
-	fmt.Printf("⚠️  %s\n", message)
+	fmt.Printf("%s%s\n", glyph("⚠️ "), message)
 }
 
 // FormatDuration formats a duration in seconds to a human-readable string
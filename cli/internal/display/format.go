@@ -0,0 +1,148 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat selects how the Print* functions render their data.
+type OutputFormat string
+
+const (
+	FormatPretty   OutputFormat = "pretty"
+	FormatJSON     OutputFormat = "json"
+	FormatYAML     OutputFormat = "yaml"
+	FormatJSONPath OutputFormat = "jsonpath"
+	FormatTemplate OutputFormat = "template"
+)
+
+var (
+	outputFormat = FormatPretty
+	outputArg    string // jsonpath expression or template source, depending on outputFormat
+	noEmoji      = os.Getenv("NO_COLOR") != ""
+)
+
+// SetOutputFormat configures how subsequent Print* calls render, from the
+// raw value of a global --output flag (e.g. "json",
+// `jsonpath={.steps[*].status}`, or `template={{.Name}}`).
+func SetOutputFormat(raw string) error {
+	format, arg, _ := strings.Cut(raw, "=")
+
+	switch OutputFormat(format) {
+	case "", FormatPretty:
+		outputFormat = FormatPretty
+	case FormatJSON:
+		outputFormat = FormatJSON
+	case FormatYAML:
+		outputFormat = FormatYAML
+	case FormatJSONPath:
+		if arg == "" {
+			return fmt.Errorf("--output=jsonpath requires an expression, e.g. --output=jsonpath={.status}")
+		}
+		outputFormat, outputArg = FormatJSONPath, arg
+	case FormatTemplate:
+		if arg == "" {
+			return fmt.Errorf("--output=template requires a template, e.g. --output=template={{.Name}}")
+		}
+		outputFormat, outputArg = FormatTemplate, arg
+	default:
+		return fmt.Errorf("unknown output format %q (want pretty, json, yaml, jsonpath, or template)", format)
+	}
+
+	return nil
+}
+
+// SetNoEmoji disables emoji decoration in pretty output, for CI environments
+// that don't render it well. NO_COLOR is honored automatically.
+func SetNoEmoji(disabled bool) {
+	noEmoji = noEmoji || disabled
+}
+
+// Outputter renders a value in a particular output format.
+type Outputter interface {
+	Output(v interface{}) error
+}
+
+type prettyOutputter struct{ render func() }
+
+func (o prettyOutputter) Output(interface{}) error {
+	o.render()
+	return nil
+}
+
+type jsonOutputter struct{}
+
+func (jsonOutputter) Output(v interface{}) error {
+	encoded, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(encoded))
+	return nil
+}
+
+type yamlOutputter struct{}
+
+func (yamlOutputter) Output(v interface{}) error {
+	encoded, err := yaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	fmt.Print(string(encoded))
+	return nil
+}
+
+type jsonpathOutputter struct{ path string }
+
+func (o jsonpathOutputter) Output(v interface{}) error {
+	result, err := evalJSONPath(o.path, v)
+	if err != nil {
+		return fmt.Errorf("failed to evaluate jsonpath %q: %w", o.path, err)
+	}
+	fmt.Println(result)
+	return nil
+}
+
+type templateOutputter struct{ source string }
+
+func (o templateOutputter) Output(v interface{}) error {
+	tmpl, err := template.New("output").Parse(o.source)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+	if err := tmpl.Execute(os.Stdout, v); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	fmt.Println()
+	return nil
+}
+
+// outputterFor picks the Outputter matching the configured --output format,
+// falling back to prettyRender for the default pretty view.
+func outputterFor(prettyRender func()) Outputter {
+	switch outputFormat {
+	case FormatJSON:
+		return jsonOutputter{}
+	case FormatYAML:
+		return yamlOutputter{}
+	case FormatJSONPath:
+		return jsonpathOutputter{path: outputArg}
+	case FormatTemplate:
+		return templateOutputter{source: outputArg}
+	default:
+		return prettyOutputter{render: prettyRender}
+	}
+}
+
+// render runs the configured Outputter for v, printing any formatting error
+// through PrintError rather than panicking or silently dropping output.
+func render(v interface{}, prettyRender func()) {
+	if err := outputterFor(prettyRender).Output(v); err != nil {
+		PrintError(err.Error())
+	}
+}
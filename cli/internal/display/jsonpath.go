@@ -0,0 +1,100 @@
+package display
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// evalJSONPath evaluates a small subset of kubectl-style JSONPath against v:
+// dotted field access (`.status`), numeric/wildcard indexing (`[0]`,
+// `[*]`), enclosed in a single top-level `{...}`. It's intentionally
+// minimal — just enough for pulling a field or column out of a Pipeline/Run
+// for scripting, not a general JSONPath implementation.
+func evalJSONPath(path string, v interface{}) (string, error) {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "{")
+	path = strings.TrimSuffix(path, "}")
+
+	// Round-trip through JSON so we can navigate generically with
+	// map[string]interface{}/[]interface{} regardless of v's Go type.
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal value: %w", err)
+	}
+	var data interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return "", fmt.Errorf("failed to unmarshal value: %w", err)
+	}
+
+	results, err := walkJSONPath(data, splitJSONPath(path))
+	if err != nil {
+		return "", err
+	}
+
+	parts := make([]string, len(results))
+	for i, r := range results {
+		parts[i] = fmt.Sprintf("%v", r)
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// splitJSONPath turns ".steps[*].status" into ["steps", "[*]", "status"].
+func splitJSONPath(path string) []string {
+	path = strings.ReplaceAll(path, "[", ".[")
+	var segments []string
+	for _, s := range strings.Split(path, ".") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+func walkJSONPath(data interface{}, segments []string) ([]interface{}, error) {
+	if len(segments) == 0 {
+		return []interface{}{data}, nil
+	}
+
+	segment := segments[0]
+	rest := segments[1:]
+
+	if strings.HasPrefix(segment, "[") && strings.HasSuffix(segment, "]") {
+		index := strings.TrimSuffix(strings.TrimPrefix(segment, "["), "]")
+
+		items, ok := data.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%q is not a list", segment)
+		}
+
+		if index == "*" {
+			var results []interface{}
+			for _, item := range items {
+				sub, err := walkJSONPath(item, rest)
+				if err != nil {
+					return nil, err
+				}
+				results = append(results, sub...)
+			}
+			return results, nil
+		}
+
+		i, err := strconv.Atoi(index)
+		if err != nil || i < 0 || i >= len(items) {
+			return nil, fmt.Errorf("index %q out of range", index)
+		}
+		return walkJSONPath(items[i], rest)
+	}
+
+	obj, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("field %q not found on a non-object value", segment)
+	}
+
+	value, ok := obj[segment]
+	if !ok {
+		return nil, fmt.Errorf("field %q not found", segment)
+	}
+	return walkJSONPath(value, rest)
+}
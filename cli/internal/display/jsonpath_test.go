@@ -0,0 +1,74 @@
+package display
+
+import "testing"
+
+func TestWalkJSONPath(t *testing.T) {
+	data := map[string]interface{}{
+		"name": "demo",
+		"steps": []interface{}{
+			map[string]interface{}{"name": "build", "status": "success"},
+			map[string]interface{}{"name": "test", "status": "failed"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    []interface{}
+		wantErr bool
+	}{
+		{
+			name: "field access",
+			path: "name",
+			want: []interface{}{"demo"},
+		},
+		{
+			name: "indexed element field",
+			path: "steps.[0].status",
+			want: []interface{}{"success"},
+		},
+		{
+			name: "wildcard element field",
+			path: "steps.[*].status",
+			want: []interface{}{"success", "failed"},
+		},
+		{
+			name:    "index out of range",
+			path:    "steps.[5].status",
+			wantErr: true,
+		},
+		{
+			name:    "field on non-object",
+			path:    "name.nested",
+			wantErr: true,
+		},
+		{
+			name:    "missing field",
+			path:    "missing",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := walkJSONPath(data, splitJSONPath(tt.path))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("walkJSONPath(%q) = %v, want error", tt.path, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("walkJSONPath(%q) returned unexpected error: %v", tt.path, err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("walkJSONPath(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("walkJSONPath(%q)[%d] = %v, want %v", tt.path, i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package display
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"custom-cicd-cli/internal/client"
+)
+
+// stepColors is the palette colorForStep hashes step names into, so
+// interleaved log output stays readable. Two steps can hash to the same
+// color.
+var stepColors = []string{
+	"\033[36m", // cyan
+	"\033[35m", // magenta
+	"\033[33m", // yellow
+	"\033[32m", // green
+	"\033[34m", // blue
+	"\033[31m", // red
+}
+
+const colorReset = "\033[0m"
+
+// colorForStep deterministically maps a step name to a color so the same
+// step keeps the same color for the life of a trace session.
+func colorForStep(step string) string {
+	h := fnv.New32a()
+	h.Write([]byte(step))
+	return stepColors[h.Sum32()%uint32(len(stepColors))]
+}
+
+// PrintLogEvent renders a single streamed log event: a colored step header
+// for output lines, or a short marker for step/run boundary events.
+func PrintLogEvent(event client.LogEvent) {
+	color := colorForStep(event.StepName)
+
+	switch event.Type {
+	case client.LogEventStepStarted:
+		fmt.Printf("%s▶ %s started%s\n", color, event.StepName, colorReset)
+	case client.LogEventStepFinished:
+		fmt.Printf("%s■ %s finished: %s%s\n", color, event.StepName, event.Status, colorReset)
+	case client.LogEventRunFinished:
+		fmt.Printf("🏁 run finished: %s\n", event.Status)
+	default:
+		marker := ""
+		if event.Stream == "stderr" {
+			marker = " stderr"
+		}
+		fmt.Printf("%s[%s%s]%s %s\n", color, event.StepName, marker, colorReset, event.Line)
+	}
+}
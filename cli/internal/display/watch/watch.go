@@ -0,0 +1,591 @@
+// Package watch implements the bubbletea TUI model behind `cicd watch`, a
+// focused single/all-run view: a step table with per-step spinners and
+// elapsed time, and a scrollable log pane for whichever step is selected.
+// It shares client.LogEvent streaming and display.StatusEmojis with
+// internal/display/dashboard rather than duplicating either.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"custom-cicd-cli/internal/client"
+	"custom-cicd-cli/internal/display"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	pollInterval    = 3 * time.Second
+	spinnerInterval = 120 * time.Millisecond
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+var (
+	headerStyle   = lipgloss.NewStyle().Bold(true).Underline(true)
+	selectedStyle = lipgloss.NewStyle().Reverse(true)
+	paneStyle     = lipgloss.NewStyle().Padding(0, 1)
+	dimStyle      = lipgloss.NewStyle().Faint(true)
+)
+
+// stepState tracks a step's status plus the start/finish times derived
+// from the log stream, since client.Step itself carries neither. finishedAt
+// and startedAt stay zero when we never saw the matching stream event
+// (e.g. a step that was already done before we attached, or when streaming
+// fell back to polling), in which case elapsed() reports zero.
+type stepState struct {
+	client.Step
+	startedAt  time.Time
+	finishedAt time.Time
+}
+
+func (s stepState) elapsed() time.Duration {
+	switch {
+	case s.startedAt.IsZero():
+		return 0
+	case s.finishedAt.IsZero():
+		return time.Since(s.startedAt)
+	default:
+		return s.finishedAt.Sub(s.startedAt)
+	}
+}
+
+func (s stepState) running() bool {
+	return !s.startedAt.IsZero() && s.finishedAt.IsZero()
+}
+
+// Model is the bubbletea model backing `cicd watch`.
+type Model struct {
+	apiClient *client.Client
+
+	// runID and all select the mode: a fixed run to watch directly, or a
+	// filterable table of every run to drill into.
+	runID string
+	all   bool
+
+	runs      []client.Run
+	runCursor int
+	filter    string
+	filtering bool
+
+	active     *client.Run
+	activeErr  error
+	steps      []stepState
+	stepCursor int
+	logCh      <-chan client.LogEvent
+	streamDead bool // set when StreamRunLogs returns an error; the run's steps then update from GetRun polling alone
+
+	// streamCancel stops the in-flight StreamRunLogs goroutine for the
+	// previously watched run; streamGen tags every message that goroutine
+	// produces so a stale one delivered during the cancellation race gets
+	// dropped instead of folded into the newly watched run's steps.
+	streamCancel context.CancelFunc
+	streamGen    int
+
+	confirmDelete bool
+	statusMsg     string
+	frame         int
+
+	width, height int
+	quitting      bool
+}
+
+// New creates a Model that watches runID directly, or (if runID is empty)
+// lists every run for the caller to pick from.
+func New(c *client.Client, runID string, all bool) Model {
+	return Model{apiClient: c, runID: runID, all: all || runID == ""}
+}
+
+type runsLoadedMsg struct {
+	runs []client.Run
+	err  error
+}
+type runLoadedMsg struct {
+	run *client.Run
+	err error
+}
+type logChReadyMsg struct {
+	ch  <-chan client.LogEvent
+	err error
+	gen int
+}
+type logEventMsg struct {
+	event client.LogEvent
+	gen   int
+}
+type logClosedMsg struct{ gen int }
+type pollTickMsg time.Time
+type spinTickMsg time.Time
+type actionDoneMsg struct {
+	verb string
+	err  error
+}
+
+func (m Model) Init() tea.Cmd {
+	cmds := []tea.Cmd{pollTick(), spinTick()}
+	if m.runID != "" {
+		cmds = append(cmds, m.loadRun(m.runID), m.initialStream(m.runID))
+	} else {
+		cmds = append(cmds, m.loadRuns())
+	}
+	return tea.Batch(cmds...)
+}
+
+func pollTick() tea.Cmd {
+	return tea.Tick(pollInterval, func(t time.Time) tea.Msg { return pollTickMsg(t) })
+}
+
+func spinTick() tea.Cmd {
+	return tea.Tick(spinnerInterval, func(t time.Time) tea.Msg { return spinTickMsg(t) })
+}
+
+func (m Model) loadRuns() tea.Cmd {
+	return func() tea.Msg {
+		runs, err := m.apiClient.ListRuns("")
+		return runsLoadedMsg{runs: runs, err: err}
+	}
+}
+
+func (m Model) loadRun(runID string) tea.Cmd {
+	return func() tea.Msg {
+		run, err := m.apiClient.GetRun(runID)
+		return runLoadedMsg{run: run, err: err}
+	}
+}
+
+// initialStream attaches to the run's live log stream for the one-shot
+// `cicd watch <runID>` case, where the watched run never changes and so
+// there's never a previous stream to cancel. If the very first attempt
+// fails, the backend is assumed not to support streaming for this run and
+// we never try again for it; GetRun polling keeps step statuses current
+// either way, just without per-step log lines or timing.
+func (m Model) initialStream(runID string) tea.Cmd {
+	return func() tea.Msg {
+		ch, err := m.apiClient.StreamRunLogs(context.Background(), runID, client.StreamOptions{Tail: 100})
+		return logChReadyMsg{ch: ch, err: err}
+	}
+}
+
+// openStream cancels any stream still running for a previously watched run
+// and opens a new one for runID, tagged with a fresh generation so
+// messages from the cancelled stream can't be mistaken for this one. Used
+// when drilling into a run from the --all picker, where re-entry after
+// backing out to the list is possible.
+func (m *Model) openStream(runID string) tea.Cmd {
+	if m.streamCancel != nil {
+		m.streamCancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.streamCancel = cancel
+	m.streamGen++
+	gen := m.streamGen
+
+	return func() tea.Msg {
+		ch, err := m.apiClient.StreamRunLogs(ctx, runID, client.StreamOptions{Tail: 100})
+		return logChReadyMsg{ch: ch, err: err, gen: gen}
+	}
+}
+
+func waitForNextEvent(ch <-chan client.LogEvent, gen int) tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-ch
+		if !ok {
+			return logClosedMsg{gen: gen}
+		}
+		return logEventMsg{event: event, gen: gen}
+	}
+}
+
+func (m Model) visibleRuns() []client.Run {
+	if m.filter == "" {
+		return m.runs
+	}
+	var out []client.Run
+	needle := strings.ToLower(m.filter)
+	for _, r := range m.runs {
+		if strings.Contains(strings.ToLower(r.Name), needle) || strings.Contains(strings.ToLower(r.Status), needle) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func (m Model) selectedRun() (client.Run, bool) {
+	visible := m.visibleRuns()
+	if m.runCursor < 0 || m.runCursor >= len(visible) {
+		return client.Run{}, false
+	}
+	return visible[m.runCursor], true
+}
+
+// watching reports whether we're currently showing a single run's steps
+// rather than the run-picker table.
+func (m Model) watching() bool {
+	return m.runID != ""
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case runsLoadedMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("refresh failed: %v", msg.err)
+			return m, nil
+		}
+		m.runs = msg.runs
+		return m, nil
+
+	case runLoadedMsg:
+		if msg.err != nil {
+			m.activeErr = msg.err
+			return m, nil
+		}
+		m.activeErr = nil
+		m.active = msg.run
+		m.mergeSteps(msg.run)
+		return m, nil
+
+	case pollTickMsg:
+		if m.watching() {
+			return m, tea.Batch(m.loadRun(m.runID), pollTick())
+		}
+		return m, tea.Batch(m.loadRuns(), pollTick())
+
+	case spinTickMsg:
+		m.frame++
+		return m, spinTick()
+
+	case logChReadyMsg:
+		if msg.gen != m.streamGen {
+			return m, nil // stream for a since-superseded run
+		}
+		if msg.err != nil {
+			m.streamDead = true
+			m.statusMsg = fmt.Sprintf("log stream unavailable, polling instead: %v", msg.err)
+			return m, nil
+		}
+		m.logCh = msg.ch
+		return m, waitForNextEvent(m.logCh, msg.gen)
+
+	case logEventMsg:
+		if msg.gen != m.streamGen {
+			return m, nil // event from a since-superseded run
+		}
+		m.applyEvent(msg.event)
+		if m.logCh != nil {
+			return m, waitForNextEvent(m.logCh, msg.gen)
+		}
+		return m, nil
+
+	case logClosedMsg:
+		if msg.gen != m.streamGen {
+			return m, nil
+		}
+		m.logCh = nil
+		return m, nil
+
+	case actionDoneMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("%s failed: %v", msg.verb, msg.err)
+		} else {
+			m.statusMsg = fmt.Sprintf("%s succeeded", msg.verb)
+		}
+		if m.watching() {
+			return m, m.loadRun(m.runID)
+		}
+		return m, m.loadRuns()
+
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		return m.handleKey(msg)
+	}
+
+	return m, nil
+}
+
+// mergeSteps updates m.steps from a freshly polled run, keeping the
+// startedAt/finishedAt timing that only the log stream provides.
+func (m *Model) mergeSteps(run *client.Run) {
+	byName := make(map[string]stepState, len(m.steps))
+	for _, s := range m.steps {
+		byName[s.Name] = s
+	}
+
+	steps := make([]stepState, len(run.Steps))
+	for i, step := range run.Steps {
+		state := byName[step.Name]
+		streamedOutput := state.Output
+		state.Step = step
+		if streamedOutput != "" {
+			state.Output = streamedOutput // prefer locally accumulated stream lines over a polled snapshot
+		}
+		steps[i] = state
+	}
+	m.steps = steps
+}
+
+// applyEvent folds a log stream event into the matching step's state
+// (line, start/finish timing). Events that arrive while no run is being
+// watched (back on the run picker) are ignored.
+func (m *Model) applyEvent(event client.LogEvent) {
+	if m.runID == "" {
+		return
+	}
+
+	for i := range m.steps {
+		if m.steps[i].Name != event.StepName {
+			continue
+		}
+		switch event.Type {
+		case client.LogEventStepStarted:
+			m.steps[i].startedAt = event.Timestamp
+		case client.LogEventStepFinished:
+			m.steps[i].finishedAt = event.Timestamp
+			m.steps[i].Status = event.Status
+		case client.LogEventLine:
+			m.steps[i].Output += event.Line + "\n"
+		}
+		return
+	}
+}
+
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.filtering {
+		switch msg.String() {
+		case "enter", "esc":
+			m.filtering = false
+		case "backspace":
+			if len(m.filter) > 0 {
+				m.filter = m.filter[:len(m.filter)-1]
+			}
+		default:
+			m.filter += msg.String()
+		}
+		return m, nil
+	}
+
+	if m.confirmDelete {
+		switch msg.String() {
+		case "y":
+			m.confirmDelete = false
+			return m, m.deleteTarget()
+		default:
+			m.confirmDelete = false
+			return m, nil
+		}
+	}
+
+	switch msg.String() {
+	case "q", "ctrl+c":
+		m.quitting = true
+		return m, tea.Quit
+	case "/":
+		m.filtering = true
+		m.filter = ""
+	case "esc":
+		if m.watching() && m.all {
+			return m.backToList(), nil
+		}
+	case "up", "k":
+		if m.watching() {
+			if m.stepCursor > 0 {
+				m.stepCursor--
+			}
+		} else if m.runCursor > 0 {
+			m.runCursor--
+		}
+	case "down", "j":
+		if m.watching() {
+			if m.stepCursor < len(m.steps)-1 {
+				m.stepCursor++
+			}
+		} else if m.runCursor < len(m.visibleRuns())-1 {
+			m.runCursor++
+		}
+	case "enter", "l":
+		if !m.watching() {
+			if run, ok := m.selectedRun(); ok {
+				m.runID = run.ID
+				m.active = nil
+				m.steps = nil
+				m.stepCursor = 0
+				m.streamDead = false
+				cmd := m.openStream(m.runID)
+				return m, tea.Batch(m.loadRun(m.runID), cmd)
+			}
+		}
+	case "c":
+		return m, m.cancelTarget()
+	case "d":
+		m.confirmDelete = true
+	}
+
+	return m, nil
+}
+
+// backToList leaves single-run mode and returns to the run picker, used
+// when `cicd watch --all` drilled into a run via enter.
+func (m Model) backToList() Model {
+	if m.streamCancel != nil {
+		m.streamCancel()
+	}
+	m.runID = ""
+	m.active, m.activeErr = nil, nil
+	m.steps, m.stepCursor = nil, 0
+	m.logCh, m.streamDead = nil, false
+	return m
+}
+
+func (m Model) cancelTarget() tea.Cmd {
+	if m.watching() {
+		runID := m.runID
+		return func() tea.Msg { return actionDoneMsg{verb: "cancel", err: m.apiClient.CancelRun(runID)} }
+	}
+	run, ok := m.selectedRun()
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg { return actionDoneMsg{verb: "cancel", err: m.apiClient.CancelRun(run.ID)} }
+}
+
+func (m Model) deleteTarget() tea.Cmd {
+	if m.watching() {
+		runID := m.runID
+		return func() tea.Msg { return actionDoneMsg{verb: "delete", err: m.apiClient.DeleteRun(runID)} }
+	}
+	run, ok := m.selectedRun()
+	if !ok {
+		return nil
+	}
+	return func() tea.Msg { return actionDoneMsg{verb: "delete", err: m.apiClient.DeleteRun(run.ID)} }
+}
+
+func (m Model) spinner() string {
+	return spinnerFrames[m.frame%len(spinnerFrames)]
+}
+
+func (m Model) View() string {
+	if m.quitting {
+		return ""
+	}
+
+	var body string
+	if m.watching() {
+		body = lipgloss.JoinHorizontal(lipgloss.Top, paneStyle.Render(m.renderSteps()), paneStyle.Render(m.renderLog()))
+	} else {
+		body = paneStyle.Render(m.renderRuns())
+	}
+
+	footer := "↑/↓ select · enter drill in · c cancel · d delete · / filter · q quit"
+	if m.watching() && m.all {
+		footer = "esc back · " + footer
+	}
+	if m.filtering {
+		footer = fmt.Sprintf("filter: %s_", m.filter)
+	}
+	if m.confirmDelete {
+		footer = "delete selected? (y/n)"
+	}
+	if m.statusMsg != "" {
+		footer = m.statusMsg + "  |  " + footer
+	}
+
+	return body + "\n" + dimStyle.Render(footer)
+}
+
+func (m Model) renderRuns() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Runs") + "\n")
+
+	visible := m.visibleRuns()
+	if len(visible) == 0 {
+		b.WriteString("no runs\n")
+		return b.String()
+	}
+
+	for i, r := range visible {
+		emoji := display.StatusEmojis[r.Status]
+		if emoji == "" {
+			emoji = "❓"
+		}
+		if r.Status == "running" {
+			emoji = m.spinner()
+		}
+		line := fmt.Sprintf("%s %-10s %s", emoji, r.Status, r.Name)
+		if i == m.runCursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	return b.String()
+}
+
+func (m Model) renderSteps() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Steps") + "\n")
+
+	if m.activeErr != nil {
+		b.WriteString(fmt.Sprintf("error: %v\n", m.activeErr))
+		return b.String()
+	}
+	if m.active != nil {
+		b.WriteString(fmt.Sprintf("%s [%s]\n\n", m.active.Name, m.active.Status))
+	}
+
+	for i, step := range m.steps {
+		emoji := display.StatusEmojis[step.Status]
+		if emoji == "" {
+			emoji = "❓"
+		}
+		if step.running() {
+			emoji = m.spinner()
+		}
+		line := fmt.Sprintf("%s %-20s %s", emoji, step.Name, formatElapsed(step.elapsed()))
+		if i == m.stepCursor {
+			line = selectedStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	if m.streamDead {
+		b.WriteString("\n" + dimStyle.Render("(log streaming unavailable; showing polled status only)") + "\n")
+	}
+
+	return b.String()
+}
+
+// renderLog shows the buffered output for the step under the cursor, so
+// scrolling through steps swaps which log is visible rather than
+// interleaving every step's output together.
+func (m Model) renderLog() string {
+	var b strings.Builder
+	b.WriteString(headerStyle.Render("Log") + "\n")
+
+	if m.stepCursor < 0 || m.stepCursor >= len(m.steps) {
+		b.WriteString("select a step\n")
+		return b.String()
+	}
+
+	step := m.steps[m.stepCursor]
+	if step.Output == "" {
+		b.WriteString(dimStyle.Render("(no output yet)") + "\n")
+		return b.String()
+	}
+	b.WriteString(step.Output)
+
+	return b.String()
+}
+
+func formatElapsed(d time.Duration) string {
+	if d <= 0 {
+		return "-"
+	}
+	return d.Round(time.Second).String()
+}
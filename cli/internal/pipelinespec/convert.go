@@ -0,0 +1,24 @@
+package pipelinespec
+
+import "custom-cicd-cli/internal/client"
+
+// ToPipeline converts a parsed Spec into the client.Pipeline the API
+// expects. Fields that exist in the DSL for schema completeness but that
+// the backend doesn't execute yet (Image, Env, When, DependsOn, Workspace,
+// Secrets — see StepSpec) are dropped here rather than silently ignored
+// by the server.
+func ToPipeline(spec *Spec) *client.Pipeline {
+	pipeline := &client.Pipeline{
+		Name:  spec.Name,
+		Steps: make([]client.Step, len(spec.Steps)),
+	}
+
+	for i, step := range spec.Steps {
+		pipeline.Steps[i] = client.Step{
+			Name:    step.Name,
+			Command: step.Command,
+		}
+	}
+
+	return pipeline
+}
@@ -0,0 +1,56 @@
+package pipelinespec
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// interpolationPattern matches `${{ env.X }}` and `${{ secrets.Y }}`
+// placeholders, capturing the namespace ("env" or "secrets") and the name.
+var interpolationPattern = regexp.MustCompile(`\$\{\{\s*(env|secrets)\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// Resolver looks up the values substituted for `${{ env.X }}` and
+// `${{ secrets.Y }}` placeholders.
+type Resolver interface {
+	Env(name string) (string, bool)
+	Secret(name string) (string, bool)
+}
+
+// Interpolate replaces every `${{ env.X }}` and `${{ secrets.Y }}`
+// placeholder in data with the value r resolves it to, before the document
+// is decoded. It returns an error naming the first placeholder that r
+// can't resolve, so a typo in a pipeline file fails loudly instead of
+// producing a step that runs with a literal "${{ ... }}" in its command.
+func Interpolate(data []byte, r Resolver) ([]byte, error) {
+	var firstErr error
+
+	result := interpolationPattern.ReplaceAllFunc(data, func(match []byte) []byte {
+		if firstErr != nil {
+			return match
+		}
+
+		groups := interpolationPattern.FindSubmatch(match)
+		namespace, name := string(groups[1]), string(groups[2])
+
+		var (
+			value string
+			ok    bool
+		)
+		switch namespace {
+		case "env":
+			value, ok = r.Env(name)
+		case "secrets":
+			value, ok = r.Secret(name)
+		}
+		if !ok {
+			firstErr = fmt.Errorf("unresolved %s.%s", namespace, name)
+			return match
+		}
+		return []byte(value)
+	})
+
+	if firstErr != nil {
+		return nil, fmt.Errorf("failed to interpolate pipeline spec: %w", firstErr)
+	}
+	return result, nil
+}
@@ -0,0 +1,86 @@
+package pipelinespec
+
+import "testing"
+
+type fakeResolver struct {
+	env     map[string]string
+	secrets map[string]string
+}
+
+func (f fakeResolver) Env(name string) (string, bool) {
+	v, ok := f.env[name]
+	return v, ok
+}
+
+func (f fakeResolver) Secret(name string) (string, bool) {
+	v, ok := f.secrets[name]
+	return v, ok
+}
+
+func TestInterpolate(t *testing.T) {
+	resolver := fakeResolver{
+		env:     map[string]string{"STAGE": "prod"},
+		secrets: map[string]string{"TOKEN": "s3cr3t"},
+	}
+
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no placeholders is a no-op",
+			data: "name: demo",
+			want: "name: demo",
+		},
+		{
+			name: "substitutes an env placeholder",
+			data: "env: ${{ env.STAGE }}",
+			want: "env: prod",
+		},
+		{
+			name: "substitutes a secrets placeholder",
+			data: "token: ${{ secrets.TOKEN }}",
+			want: "token: s3cr3t",
+		},
+		{
+			name: "tolerates extra whitespace inside the braces",
+			data: "env: ${{  env.STAGE  }}",
+			want: "env: prod",
+		},
+		{
+			name: "substitutes every occurrence",
+			data: "${{ env.STAGE }} and ${{ env.STAGE }}",
+			want: "prod and prod",
+		},
+		{
+			name:    "errors on an unresolved env var",
+			data:    "env: ${{ env.MISSING }}",
+			wantErr: true,
+		},
+		{
+			name:    "errors on an unresolved secret",
+			data:    "token: ${{ secrets.MISSING }}",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Interpolate([]byte(tt.data), resolver)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("Interpolate(%q) = %q, want error", tt.data, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Interpolate(%q) returned unexpected error: %v", tt.data, err)
+			}
+			if string(got) != tt.want {
+				t.Fatalf("Interpolate(%q) = %q, want %q", tt.data, got, tt.want)
+			}
+		})
+	}
+}
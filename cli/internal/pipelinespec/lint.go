@@ -0,0 +1,128 @@
+package pipelinespec
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Issue is a single problem Lint found, with a 1-based line/column into
+// the source document so editors and CI logs can point at it directly.
+type Issue struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (i Issue) String() string {
+	return fmt.Sprintf("%d:%d: %s", i.Line, i.Column, i.Message)
+}
+
+// rootKeys and stepKeys mirror schema.json's "properties" (which sets
+// additionalProperties:false at both levels), so Lint can flag a typo'd
+// or unsupported key instead of letting it silently do nothing.
+var (
+	rootKeys = map[string]bool{"name": true, "steps": true}
+	stepKeys = map[string]bool{
+		"name": true, "command": true, "image": true, "env": true,
+		"when": true, "depends_on": true, "workspace": true, "secrets": true,
+	}
+)
+
+// Lint checks a pipeline DSL document against the rules in schema.json
+// (required fields, unknown properties) plus cross-field rules a static
+// schema can't express (duplicate step names, depends_on referencing a
+// step that doesn't exist). It decodes with yaml.Node rather than a
+// generic JSON Schema validator so every issue carries the offending
+// field's line and column; valid JSON parses as YAML, so this also
+// covers the JSON dialect of the DSL.
+func Lint(data []byte) []Issue {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return []Issue{{Line: 1, Column: 1, Message: fmt.Sprintf("failed to parse document: %v", err)}}
+	}
+	if len(doc.Content) == 0 {
+		return []Issue{{Line: 1, Column: 1, Message: "document is empty"}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return []Issue{{Line: root.Line, Column: root.Column, Message: "pipeline must be a mapping with 'name' and 'steps'"}}
+	}
+
+	var issues []Issue
+	issues = append(issues, unknownKeyIssues(root, rootKeys)...)
+
+	nameNode := mapValue(root, "name")
+	if nameNode == nil || nameNode.Value == "" {
+		issues = append(issues, Issue{root.Line, root.Column, "missing required field 'name'"})
+	}
+
+	stepsNode := mapValue(root, "steps")
+	if stepsNode == nil || stepsNode.Kind != yaml.SequenceNode || len(stepsNode.Content) == 0 {
+		issues = append(issues, Issue{root.Line, root.Column, "missing required field 'steps' (must be a non-empty list)"})
+		return issues
+	}
+
+	seen := make(map[string]bool, len(stepsNode.Content))
+	for _, step := range stepsNode.Content {
+		if step.Kind != yaml.MappingNode {
+			issues = append(issues, Issue{step.Line, step.Column, "step must be a mapping"})
+			continue
+		}
+		issues = append(issues, unknownKeyIssues(step, stepKeys)...)
+
+		nameNode := mapValue(step, "name")
+		if nameNode == nil || nameNode.Value == "" {
+			issues = append(issues, Issue{step.Line, step.Column, "step is missing required field 'name'"})
+		} else if seen[nameNode.Value] {
+			issues = append(issues, Issue{nameNode.Line, nameNode.Column, fmt.Sprintf("duplicate step name %q", nameNode.Value)})
+		} else {
+			seen[nameNode.Value] = true
+		}
+
+		if cmdNode := mapValue(step, "command"); cmdNode == nil || cmdNode.Value == "" {
+			issues = append(issues, Issue{step.Line, step.Column, "step is missing required field 'command'"})
+		}
+	}
+
+	for _, step := range stepsNode.Content {
+		if step.Kind != yaml.MappingNode {
+			continue
+		}
+		dependsOn := mapValue(step, "depends_on")
+		if dependsOn == nil {
+			continue
+		}
+		for _, dep := range dependsOn.Content {
+			if !seen[dep.Value] {
+				issues = append(issues, Issue{dep.Line, dep.Column, fmt.Sprintf("depends_on references unknown step %q", dep.Value)})
+			}
+		}
+	}
+
+	return issues
+}
+
+// mapValue returns the value node for key in a YAML mapping node, or nil
+// if key isn't present.
+func mapValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// unknownKeyIssues flags any key in mapping that isn't in allowed,
+// mirroring the schema's additionalProperties:false at that level.
+func unknownKeyIssues(mapping *yaml.Node, allowed map[string]bool) []Issue {
+	var issues []Issue
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i]
+		if !allowed[key.Value] {
+			issues = append(issues, Issue{key.Line, key.Column, fmt.Sprintf("unknown property %q", key.Value)})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,111 @@
+package pipelinespec
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantContain []string // substrings every expected issue message must contain, in order
+	}{
+		{
+			name: "valid pipeline has no issues",
+			data: `
+name: demo
+steps:
+  - name: build
+    command: make build
+  - name: test
+    command: make test
+    depends_on: [build]
+`,
+		},
+		{
+			name: "missing name",
+			data: `
+steps:
+  - name: build
+    command: make build
+`,
+			wantContain: []string{"missing required field 'name'"},
+		},
+		{
+			name: "missing steps",
+			data: `
+name: demo
+`,
+			wantContain: []string{"missing required field 'steps'"},
+		},
+		{
+			name: "step missing command",
+			data: `
+name: demo
+steps:
+  - name: build
+`,
+			wantContain: []string{"missing required field 'command'"},
+		},
+		{
+			name: "duplicate step names",
+			data: `
+name: demo
+steps:
+  - name: build
+    command: make build
+  - name: build
+    command: make build-again
+`,
+			wantContain: []string{`duplicate step name "build"`},
+		},
+		{
+			name: "depends_on references unknown step",
+			data: `
+name: demo
+steps:
+  - name: build
+    command: make build
+    depends_on: [missing]
+`,
+			wantContain: []string{`depends_on references unknown step "missing"`},
+		},
+		{
+			name: "unknown root property",
+			data: `
+name: demo
+steps:
+  - name: build
+    command: make build
+triggers: [push]
+`,
+			wantContain: []string{`unknown property "triggers"`},
+		},
+		{
+			name: "unknown step property",
+			data: `
+name: demo
+steps:
+  - name: build
+    command: make build
+    retries: 3
+`,
+			wantContain: []string{`unknown property "retries"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := Lint([]byte(tt.data))
+			if len(issues) != len(tt.wantContain) {
+				t.Fatalf("Lint() = %v, want %d issue(s) containing %v", issues, len(tt.wantContain), tt.wantContain)
+			}
+			for i, want := range tt.wantContain {
+				if !strings.Contains(issues[i].Message, want) {
+					t.Fatalf("Lint()[%d].Message = %q, want it to contain %q", i, issues[i].Message, want)
+				}
+			}
+		})
+	}
+}
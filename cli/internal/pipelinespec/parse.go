@@ -0,0 +1,36 @@
+package pipelinespec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Parse decodes data as a pipeline DSL document, auto-detecting the format
+// from filename's extension the same way cmd's pipeline-file loading does:
+// ".yaml"/".yml" forces YAML, ".json" forces JSON, and anything else
+// (stdin, inline flags) tries JSON first and falls back to YAML.
+func Parse(data []byte, filename string) (*Spec, error) {
+	var spec Spec
+
+	switch {
+	case strings.HasSuffix(filename, ".yaml") || strings.HasSuffix(filename, ".yml"):
+		if err := yaml.Unmarshal(data, &spec); err != nil {
+			return nil, fmt.Errorf("failed to decode pipeline YAML: %w", err)
+		}
+	case strings.HasSuffix(filename, ".json"):
+		if err := json.Unmarshal(data, &spec); err != nil {
+			return nil, fmt.Errorf("failed to decode pipeline JSON: %w", err)
+		}
+	default:
+		if jsonErr := json.Unmarshal(data, &spec); jsonErr != nil {
+			if yamlErr := yaml.Unmarshal(data, &spec); yamlErr != nil {
+				return nil, fmt.Errorf("failed to decode pipeline spec as JSON or YAML")
+			}
+		}
+	}
+
+	return &spec, nil
+}
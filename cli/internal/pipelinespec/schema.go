@@ -0,0 +1,15 @@
+package pipelinespec
+
+import _ "embed"
+
+// schemaJSON is the JSON Schema (draft-07) describing the pipeline DSL,
+// shipped so editors can validate pipeline files as they're written and so
+// `cicd schema print` has a single source of truth to emit.
+//
+//go:embed schema.json
+var schemaJSON []byte
+
+// Schema returns the embedded JSON Schema document for the pipeline DSL.
+func Schema() []byte {
+	return schemaJSON
+}
@@ -0,0 +1,24 @@
+// Package pipelinespec parses the declarative pipeline DSL (name + steps
+// with image/env/when/depends_on/workspace/secrets) used by `cicd pipeline
+// create-and-run` and `cicd lint`, and converts it into a client.Pipeline.
+package pipelinespec
+
+// Spec is the parsed form of a pipeline DSL document.
+type Spec struct {
+	Name  string     `yaml:"name" json:"name"`
+	Steps []StepSpec `yaml:"steps" json:"steps"`
+}
+
+// StepSpec is a single step in the DSL. Only Name and Command reach the
+// backend: ToPipeline drops Image, Env, When, DependsOn, Workspace and
+// Secrets, which are parsed and linted but not submitted.
+type StepSpec struct {
+	Name      string            `yaml:"name" json:"name"`
+	Command   string            `yaml:"command" json:"command"`
+	Image     string            `yaml:"image,omitempty" json:"image,omitempty"`
+	Env       map[string]string `yaml:"env,omitempty" json:"env,omitempty"`
+	When      string            `yaml:"when,omitempty" json:"when,omitempty"`
+	DependsOn []string          `yaml:"depends_on,omitempty" json:"depends_on,omitempty"`
+	Workspace string            `yaml:"workspace,omitempty" json:"workspace,omitempty"`
+	Secrets   []string          `yaml:"secrets,omitempty" json:"secrets,omitempty"`
+}